@@ -0,0 +1,56 @@
+/*
+Copyright © LiquidWeb
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package cache
+
+import (
+	"time"
+)
+
+const CacheTtlKey = "liquidweb.cache.ttl"
+const DefaultTtl = time.Hour
+
+// cacheableMethods are read-only catalog methods whose responses rarely change. Only
+// these are ever served from the on-disk cache.
+var cacheableMethods = map[string]bool{
+	"bleed/storm/config/list":               true,
+	"bleed/storm/config/details":            true,
+	"bleed/storm/template/list":             true,
+	"bleed/network/zone/list":               true,
+	"bleed/network/zone/details":            true,
+	"bleed/network/zone/region/list":        true,
+	"bleed/network/zone/region/details":     true,
+	"bleed/network/loadbalancer/strategies": true,
+	// cached for resolving parents by name; details aren't, as they report the
+	// parent's free and used resources
+	"bleed/storm/private/parent/list": true,
+}
+
+// invalidatingMethods change what cached methods return; a successful call to one
+// drops the cached responses of the methods it maps to.
+var invalidatingMethods = map[string][]string{
+	"bleed/storm/private/parent/create": privateParentMethods,
+	"bleed/storm/private/parent/update": privateParentMethods,
+	"bleed/storm/private/parent/delete": privateParentMethods,
+	// instances placed on a private parent change what it reports
+	"bleed/server/create":  privateParentMethods,
+	"bleed/server/clone":   privateParentMethods,
+	"bleed/server/resize":  privateParentMethods,
+	"bleed/server/destroy": privateParentMethods,
+}
+
+var privateParentMethods = []string{
+	"bleed/storm/private/parent/list",
+}
@@ -0,0 +1,192 @@
+/*
+Copyright © LiquidWeb
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package cache
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+type entry struct {
+	Stored   time.Time   `json:"stored"`
+	Method   string      `json:"method"`
+	Response interface{} `json:"response"`
+}
+
+// Cacheable reports whether responses from the given API method may be cached.
+func Cacheable(method string) bool {
+	return cacheableMethods[method]
+}
+
+// Dir returns the directory cache entries are stored in.
+func Dir() (dir string, err error) {
+	var base string
+	base, err = os.UserCacheDir()
+	if err != nil {
+		err = fmt.Errorf("%w: %s", ErrorNoCacheDir, err)
+		return
+	}
+
+	dir = filepath.Join(base, "liquidweb-cli")
+
+	return
+}
+
+// Account identifies whose responses a cache entry holds. A context removed and added
+// again under the same name for another API URL or user doesn't see the old entries.
+type Account struct {
+	Context  string
+	Url      string
+	Username string
+}
+
+// Key derives a cache key from the account, method and method arguments. Map keys are
+// sorted by json.Marshal, so equivalent arguments always produce the same key.
+func Key(account Account, method string, params interface{}) (key string, err error) {
+	var encoded []byte
+	encoded, err = json.Marshal([]interface{}{account.Context, account.Url, account.Username, method, params})
+	if err != nil {
+		return
+	}
+
+	sum := sha256.Sum256(encoded)
+	key = hex.EncodeToString(sum[:])
+
+	return
+}
+
+// Get returns the cached response for key if one exists that is younger than ttl.
+func Get(key string, ttl time.Duration) (got interface{}, found bool) {
+	file, err := entryFile(key)
+	if err != nil {
+		return
+	}
+
+	data, err := ioutil.ReadFile(filepath.Clean(file))
+	if err != nil {
+		return
+	}
+
+	var e entry
+	if err := json.Unmarshal(data, &e); err != nil {
+		return
+	}
+
+	if time.Since(e.Stored) > ttl {
+		return
+	}
+
+	got = e.Response
+	found = true
+
+	return
+}
+
+// Set stores got as the cached response for key.
+func Set(key, method string, got interface{}) (err error) {
+	var file string
+	file, err = entryFile(key)
+	if err != nil {
+		return
+	}
+
+	if err = os.MkdirAll(filepath.Dir(file), 0700); err != nil {
+		err = fmt.Errorf("%w: %s", ErrorUnwritable, err)
+		return
+	}
+
+	var data []byte
+	data, err = json.Marshal(entry{Stored: time.Now(), Method: method, Response: got})
+	if err != nil {
+		return
+	}
+
+	if err = ioutil.WriteFile(file, data, 0600); err != nil {
+		err = fmt.Errorf("%w: %s", ErrorUnwritable, err)
+	}
+
+	return
+}
+
+// Invalidate drops the cached responses of every method a successful call to method
+// changes.
+func Invalidate(method string) (err error) {
+	methods := invalidatingMethods[method]
+	if len(methods) == 0 {
+		return
+	}
+
+	var dir string
+	if dir, err = Dir(); err != nil {
+		return
+	}
+	files, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	if err != nil {
+		return
+	}
+	for _, file := range files {
+		data, readErr := ioutil.ReadFile(filepath.Clean(file))
+		if readErr != nil {
+			continue
+		}
+		var e entry
+		if json.Unmarshal(data, &e) != nil {
+			continue
+		}
+		for _, invalidated := range methods {
+			if e.Method == invalidated {
+				if err = os.Remove(file); err != nil && !os.IsNotExist(err) {
+					return
+				}
+				err = nil
+				break
+			}
+		}
+	}
+
+	return
+}
+
+// Clear removes every cache entry.
+func Clear() (err error) {
+	var dir string
+	dir, err = Dir()
+	if err != nil {
+		return
+	}
+
+	err = os.RemoveAll(dir)
+
+	return
+}
+
+func entryFile(key string) (file string, err error) {
+	var dir string
+	dir, err = Dir()
+	if err != nil {
+		return
+	}
+
+	file = filepath.Join(dir, key+".json")
+
+	return
+}
@@ -0,0 +1,68 @@
+/*
+Copyright © LiquidWeb
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package client
+
+import (
+	"context"
+
+	"github.com/liquidweb/liquidweb-cli/types/api"
+)
+
+type AssetService struct {
+	client *Client
+}
+
+type AssetListRequest struct {
+	ListOptions
+	Category []string `json:"category,omitempty"`
+	Type     string   `json:"type,omitempty"`
+	AlsoWith []string `json:"alsowith,omitempty"`
+}
+
+// Details calls bleed/asset/details. alsoWith names extra data to include, such as
+// "categories".
+func (s *AssetService) Details(ctx context.Context, uniqId string, alsoWith ...string) (*apiTypes.Subaccnt, error) {
+	params := map[string]interface{}{"uniq_id": uniqId}
+	if len(alsoWith) > 0 {
+		params["alsowith"] = alsoWith
+	}
+
+	var resp apiTypes.Subaccnt
+	if err := s.client.Call(ctx, "bleed/asset/details", params, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// Each calls fn for every asset bleed/asset/list returns.
+func (s *AssetService) Each(ctx context.Context, req *AssetListRequest, fn func(*apiTypes.Subaccnt) (bool, error)) error {
+	_, _, err := s.client.Paginate(ctx, "bleed/asset/list", req, req.ListOptions,
+		func(item map[string]interface{}) (bool, error) {
+			var asset apiTypes.Subaccnt
+			if err := Decode(item, &asset); err != nil {
+				return false, err
+			}
+			return fn(&asset)
+		})
+
+	return err
+}
+
+// All returns every raw item bleed/asset/list returns.
+func (s *AssetService) All(ctx context.Context, req *AssetListRequest) (apiTypes.MergedPaginatedList, error) {
+	return s.client.All(ctx, "bleed/asset/list", req, req.ListOptions)
+}
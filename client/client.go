@@ -0,0 +1,118 @@
+/*
+Copyright © LiquidWeb
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Package client is a typed client for the LiquidWeb API methods liquidweb-cli uses.
+//
+// Services are laid out the same way as the API method names, so
+// "bleed/storm/server/details" is Client.Storm.Server.Details. Every method
+// takes a context.Context, which is passed through to the underlying Caller.
+package client
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"github.com/mitchellh/mapstructure"
+
+	"github.com/liquidweb/liquidweb-cli/types/errors"
+)
+
+// Caller performs a single raw API call. *api.LwCliApiClient satisfies it.
+type Caller interface {
+	CallContext(ctx context.Context, method string, params interface{}) (interface{}, error)
+}
+
+// CallerFunc adapts an ordinary function to a Caller.
+type CallerFunc func(ctx context.Context, method string, params interface{}) (interface{}, error)
+
+func (f CallerFunc) CallContext(ctx context.Context, method string, params interface{}) (interface{}, error) {
+	return f(ctx, method, params)
+}
+
+type Client struct {
+	caller Caller
+
+	Asset     *AssetService
+	Network   *NetworkService
+	Server    *ServerService
+	Storage   *StorageService
+	Storm     *StormService
+	Utilities *UtilitiesService
+	Vip       *VipService
+}
+
+// New returns a Client making its API calls through caller.
+func New(caller Caller) *Client {
+	c := &Client{caller: caller}
+
+	c.Asset = &AssetService{client: c}
+	c.Network = newNetworkService(c)
+	c.Server = &ServerService{client: c}
+	c.Storage = newStorageService(c)
+	c.Storm = newStormService(c)
+	c.Utilities = newUtilitiesService(c)
+	c.Vip = &VipService{client: c}
+
+	return c
+}
+
+// IntBool is a bool sent to the API as 1 or 0, which is how most methods expect
+// their flags.
+type IntBool bool
+
+func (b IntBool) MarshalJSON() ([]byte, error) {
+	if b {
+		return []byte("1"), nil
+	}
+	return []byte("0"), nil
+}
+
+// Call calls method with params and decodes the result into out, which should be
+// a pointer. A nil out discards the result.
+func (c *Client) Call(ctx context.Context, method string, params interface{}, out interface{}) (err error) {
+	if params == nil {
+		params = map[string]interface{}{}
+	}
+
+	got, err := c.caller.CallContext(ctx, method, params)
+	if err != nil || out == nil {
+		return
+	}
+
+	err = Decode(got, out)
+
+	return
+}
+
+// Decode converts a raw API result into dest, weakly typing fields along the way
+// (the API is not always consistent about returning numbers vs strings).
+func Decode(source interface{}, dest interface{}) (err error) {
+	defer func() {
+		if paniced := recover(); paniced != nil {
+			err = fmt.Errorf("%w source [%+v] dest type [%s]: %+v",
+				errorTypes.LwApiUnexpectedResponseStructure, source,
+				reflect.TypeOf(dest).String(), paniced)
+		}
+	}()
+
+	if err = mapstructure.WeakDecode(source, &dest); err != nil {
+		err = fmt.Errorf("%w\nsource [%+v] dest type [%s] error: %+v",
+			errorTypes.LwApiUnexpectedResponseStructure, source,
+			reflect.TypeOf(dest).String(), err)
+	}
+
+	return
+}
@@ -0,0 +1,439 @@
+/*
+Copyright © LiquidWeb
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package client
+
+import (
+	"context"
+
+	"github.com/liquidweb/liquidweb-cli/types/api"
+)
+
+type NetworkService struct {
+	Ip           *NetworkIpService
+	Pool         *NetworkPoolService
+	Private      *NetworkPrivateService
+	Zone         *NetworkZoneService
+	LoadBalancer *NetworkLoadBalancerService
+}
+
+func newNetworkService(c *Client) *NetworkService {
+	return &NetworkService{
+		Ip:           &NetworkIpService{client: c},
+		Pool:         &NetworkPoolService{client: c},
+		Private:      &NetworkPrivateService{client: c},
+		Zone:         &NetworkZoneService{client: c},
+		LoadBalancer: &NetworkLoadBalancerService{client: c},
+	}
+}
+
+/* bleed/network/ip */
+
+type NetworkIpService struct {
+	client *Client
+}
+
+type NetworkIpListRequest struct {
+	ListOptions
+	UniqId    string  `json:"uniq_id"`
+	ExpandIps IntBool `json:"expand_ips"`
+}
+
+type NetworkIpAddRequest struct {
+	UniqId       string   `json:"uniq_id"`
+	ConfigureIps bool     `json:"configure_ips"`
+	IpCount      int64    `json:"ip_count,omitempty"`
+	Ip6Count     int64    `json:"ip6_count,omitempty"`
+	PoolIps      []string `json:"pool_ips,omitempty"`
+	Pool6Ips     []string `json:"pool6_ips,omitempty"`
+}
+
+type NetworkIpRemoveRequest struct {
+	UniqId       string `json:"uniq_id"`
+	Ip           string `json:"ip"`
+	ConfigureIps bool   `json:"configure_ips"`
+}
+
+// Each calls fn for every IP assignment bleed/network/ip/list returns.
+func (s *NetworkIpService) Each(ctx context.Context, req *NetworkIpListRequest,
+	fn func(*apiTypes.NetworkAssignmentListEntry) (bool, error)) error {
+	_, _, err := s.client.Paginate(ctx, "bleed/network/ip/list", req, req.ListOptions,
+		func(item map[string]interface{}) (bool, error) {
+			var assignment apiTypes.NetworkAssignmentListEntry
+			if err := Decode(item, &assignment); err != nil {
+				return false, err
+			}
+			return fn(&assignment)
+		})
+
+	return err
+}
+
+// Add calls bleed/network/ip/add.
+func (s *NetworkIpService) Add(ctx context.Context, req *NetworkIpAddRequest) (*apiTypes.NetworkIpAdd, error) {
+	var resp apiTypes.NetworkIpAdd
+	if err := s.client.Call(ctx, "bleed/network/ip/add", req, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// Remove calls bleed/network/ip/remove.
+func (s *NetworkIpService) Remove(ctx context.Context, req *NetworkIpRemoveRequest) (*apiTypes.NetworkIpRemove, error) {
+	var resp apiTypes.NetworkIpRemove
+	if err := s.client.Call(ctx, "bleed/network/ip/remove", req, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+/* bleed/network/pool */
+
+type NetworkPoolService struct {
+	client *Client
+}
+
+type NetworkPoolDetailsRequest struct {
+	// one of Id or UniqId identifies the pool
+	Id       int64  `json:"id,omitempty"`
+	UniqId   string `json:"uniq_id,omitempty"`
+	FreeOnly bool   `json:"free_only,omitempty"`
+}
+
+type NetworkPoolCreateRequest struct {
+	ZoneId int64 `json:"zone_id"`
+	// NewIps is sent when set, 0 included; AddIps otherwise.
+	NewIps *int64   `json:"new_ips,omitempty"`
+	AddIps []string `json:"add_ips,omitempty"`
+}
+
+type NetworkPoolUpdateRequest struct {
+	UniqId    string   `json:"uniq_id"`
+	NewIps    int64    `json:"new_ips,omitempty"`
+	AddIps    []string `json:"add_ips,omitempty"`
+	RemoveIps []string `json:"remove_ips,omitempty"`
+}
+
+// Each calls fn for every IP pool bleed/network/pool/list returns.
+func (s *NetworkPoolService) Each(ctx context.Context, opts ListOptions,
+	fn func(*apiTypes.NetworkIpPoolListEntry) (bool, error)) error {
+	_, _, err := s.client.Paginate(ctx, "bleed/network/pool/list", nil, opts,
+		func(item map[string]interface{}) (bool, error) {
+			var pool apiTypes.NetworkIpPoolListEntry
+			if err := Decode(item, &pool); err != nil {
+				return false, err
+			}
+			return fn(&pool)
+		})
+
+	return err
+}
+
+// Details calls bleed/network/pool/details.
+func (s *NetworkPoolService) Details(ctx context.Context,
+	req *NetworkPoolDetailsRequest) (*apiTypes.NetworkIpPoolDetails, error) {
+	var resp apiTypes.NetworkIpPoolDetails
+	if err := s.client.Call(ctx, "bleed/network/pool/details", req, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// Create calls bleed/network/pool/create.
+func (s *NetworkPoolService) Create(ctx context.Context,
+	req *NetworkPoolCreateRequest) (*apiTypes.NetworkIpPoolDetails, error) {
+	var resp apiTypes.NetworkIpPoolDetails
+	if err := s.client.Call(ctx, "bleed/network/pool/create", req, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// Update calls bleed/network/pool/update.
+func (s *NetworkPoolService) Update(ctx context.Context,
+	req *NetworkPoolUpdateRequest) (*apiTypes.NetworkIpPoolDetails, error) {
+	var resp apiTypes.NetworkIpPoolDetails
+	if err := s.client.Call(ctx, "bleed/network/pool/update", req, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// Delete calls bleed/network/pool/delete.
+func (s *NetworkPoolService) Delete(ctx context.Context, uniqId string) (*apiTypes.NetworkIpPoolDelete, error) {
+	var resp apiTypes.NetworkIpPoolDelete
+	if err := s.client.Call(ctx, "bleed/network/pool/delete", map[string]interface{}{"uniq_id": uniqId},
+		&resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+/* bleed/network/private */
+
+type NetworkPrivateService struct {
+	client *Client
+}
+
+// Attach calls bleed/network/private/attach.
+func (s *NetworkPrivateService) Attach(ctx context.Context,
+	uniqId string) (*apiTypes.CloudNetworkPrivateAttachResponse, error) {
+	var resp apiTypes.CloudNetworkPrivateAttachResponse
+	if err := s.client.Call(ctx, "bleed/network/private/attach", map[string]interface{}{"uniq_id": uniqId},
+		&resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// Detach calls bleed/network/private/detach.
+func (s *NetworkPrivateService) Detach(ctx context.Context,
+	uniqId string) (*apiTypes.CloudNetworkPrivateDetachResponse, error) {
+	var resp apiTypes.CloudNetworkPrivateDetachResponse
+	if err := s.client.Call(ctx, "bleed/network/private/detach", map[string]interface{}{"uniq_id": uniqId},
+		&resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// GetIp calls bleed/network/private/getip.
+func (s *NetworkPrivateService) GetIp(ctx context.Context,
+	uniqId string) (*apiTypes.CloudNetworkPrivateGetIpResponse, error) {
+	var resp apiTypes.CloudNetworkPrivateGetIpResponse
+	if err := s.client.Call(ctx, "bleed/network/private/getip", map[string]interface{}{"uniq_id": uniqId},
+		&resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// IsAttached calls bleed/network/private/isattached.
+func (s *NetworkPrivateService) IsAttached(ctx context.Context,
+	uniqId string) (*apiTypes.CloudNetworkPrivateIsAttachedResponse, error) {
+	var resp apiTypes.CloudNetworkPrivateIsAttachedResponse
+	if err := s.client.Call(ctx, "bleed/network/private/isattached", map[string]interface{}{"uniq_id": uniqId},
+		&resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+/* bleed/network/zone */
+
+type NetworkZoneService struct {
+	client *Client
+}
+
+// Each calls fn for every zone bleed/network/zone/list returns.
+func (s *NetworkZoneService) Each(ctx context.Context, opts ListOptions,
+	fn func(*apiTypes.NetworkZoneDetails) (bool, error)) error {
+	_, _, err := s.client.Paginate(ctx, "bleed/network/zone/list", nil, opts,
+		func(item map[string]interface{}) (bool, error) {
+			var zone apiTypes.NetworkZoneDetails
+			if err := Decode(item, &zone); err != nil {
+				return false, err
+			}
+			return fn(&zone)
+		})
+
+	return err
+}
+
+// Details calls bleed/network/zone/details.
+func (s *NetworkZoneService) Details(ctx context.Context, id int64) (*apiTypes.NetworkZoneDetails, error) {
+	var resp apiTypes.NetworkZoneDetails
+	if err := s.client.Call(ctx, "bleed/network/zone/details", map[string]interface{}{"id": id},
+		&resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+/* bleed/network/loadbalancer */
+
+type NetworkLoadBalancerService struct {
+	client *Client
+}
+
+type NetworkLoadBalancerCreateRequest struct {
+	Name           string                           `json:"name"`
+	Strategy       string                           `json:"strategy"`
+	Region         int                              `json:"region,omitempty"`
+	SslTermination *bool                            `json:"ssl_termination,omitempty"`
+	SslIncludes    *bool                            `json:"ssl_includes,omitempty"`
+	SslCert        string                           `json:"ssl_cert,omitempty"`
+	SslKey         string                           `json:"ssl_key,omitempty"`
+	SslInt         string                           `json:"ssl_int,omitempty"`
+	Nodes          []string                         `json:"nodes,omitempty"`
+	Services       []NetworkLoadBalancerServiceSpec `json:"services"`
+}
+
+// NetworkLoadBalancerUpdateRequest only sends the fields that are set.
+type NetworkLoadBalancerUpdateRequest struct {
+	UniqId         string                           `json:"uniq_id"`
+	Name           string                           `json:"name,omitempty"`
+	Strategy       string                           `json:"strategy,omitempty"`
+	SslTermination *bool                            `json:"ssl_termination,omitempty"`
+	SslIncludes    *bool                            `json:"ssl_includes,omitempty"`
+	SslCert        string                           `json:"ssl_cert,omitempty"`
+	SslKey         string                           `json:"ssl_key,omitempty"`
+	SslInt         string                           `json:"ssl_int,omitempty"`
+	Nodes          []string                         `json:"nodes,omitempty"`
+	Services       []NetworkLoadBalancerServiceSpec `json:"services,omitempty"`
+}
+
+// HasChanges reports whether the request sets anything besides UniqId.
+func (r *NetworkLoadBalancerUpdateRequest) HasChanges() bool {
+	return r.Name != "" || r.Strategy != "" || r.SslTermination != nil || r.SslIncludes != nil ||
+		r.SslCert != "" || r.SslKey != "" || r.SslInt != "" || len(r.Nodes) > 0 || len(r.Services) > 0
+}
+
+// NetworkLoadBalancerServiceSpec is a source/destination port pair to balance.
+type NetworkLoadBalancerServiceSpec struct {
+	SrcPort     int                    `json:"src_port"`
+	DestPort    int                    `json:"dest_port"`
+	HealthCheck map[string]interface{} `json:"health_check,omitempty"`
+}
+
+// Each calls fn for every load balancer bleed/network/loadbalancer/list returns.
+func (s *NetworkLoadBalancerService) Each(ctx context.Context, opts ListOptions,
+	fn func(*apiTypes.NetworkLoadBalancerDetails) (bool, error)) error {
+	_, _, err := s.client.Paginate(ctx, "bleed/network/loadbalancer/list", nil, opts,
+		func(item map[string]interface{}) (bool, error) {
+			var loadBalancer apiTypes.NetworkLoadBalancerDetails
+			if err := Decode(item, &loadBalancer); err != nil {
+				return false, err
+			}
+			return fn(&loadBalancer)
+		})
+
+	return err
+}
+
+// Details calls bleed/network/loadbalancer/details.
+func (s *NetworkLoadBalancerService) Details(ctx context.Context,
+	uniqId string) (*apiTypes.NetworkLoadBalancerDetails, error) {
+	return s.call(ctx, "bleed/network/loadbalancer/details", map[string]interface{}{"uniq_id": uniqId})
+}
+
+// Create calls bleed/network/loadbalancer/create.
+func (s *NetworkLoadBalancerService) Create(ctx context.Context,
+	req *NetworkLoadBalancerCreateRequest) (*apiTypes.NetworkLoadBalancerDetails, error) {
+	return s.call(ctx, "bleed/network/loadbalancer/create", req)
+}
+
+// Update calls bleed/network/loadbalancer/update.
+func (s *NetworkLoadBalancerService) Update(ctx context.Context,
+	req *NetworkLoadBalancerUpdateRequest) (*apiTypes.NetworkLoadBalancerDetails, error) {
+	return s.call(ctx, "bleed/network/loadbalancer/update", req)
+}
+
+// Delete calls bleed/network/loadbalancer/delete.
+func (s *NetworkLoadBalancerService) Delete(ctx context.Context,
+	uniqId string) (*apiTypes.NetworkLoadBalancerDelete, error) {
+	var resp apiTypes.NetworkLoadBalancerDelete
+	if err := s.client.Call(ctx, "bleed/network/loadbalancer/delete", map[string]interface{}{"uniq_id": uniqId},
+		&resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// AddNode calls bleed/network/loadbalancer/addnode.
+func (s *NetworkLoadBalancerService) AddNode(ctx context.Context, uniqId string,
+	node string) (*apiTypes.NetworkLoadBalancerDetails, error) {
+	return s.call(ctx, "bleed/network/loadbalancer/addnode", map[string]interface{}{
+		"uniq_id": uniqId,
+		"node":    node,
+	})
+}
+
+// RemoveNode calls bleed/network/loadbalancer/removenode.
+func (s *NetworkLoadBalancerService) RemoveNode(ctx context.Context, uniqId string,
+	node string) (*apiTypes.NetworkLoadBalancerDetails, error) {
+	return s.call(ctx, "bleed/network/loadbalancer/removenode", map[string]interface{}{
+		"uniq_id": uniqId,
+		"node":    node,
+	})
+}
+
+// AddService calls bleed/network/loadbalancer/addservice.
+func (s *NetworkLoadBalancerService) AddService(ctx context.Context, uniqId string, srcPort int,
+	destPort int) (*apiTypes.NetworkLoadBalancerDetails, error) {
+	return s.call(ctx, "bleed/network/loadbalancer/addservice", map[string]interface{}{
+		"uniq_id":   uniqId,
+		"src_port":  srcPort,
+		"dest_port": destPort,
+	})
+}
+
+// RemoveService calls bleed/network/loadbalancer/removeservice.
+func (s *NetworkLoadBalancerService) RemoveService(ctx context.Context, uniqId string,
+	srcPort int) (*apiTypes.NetworkLoadBalancerDetails, error) {
+	return s.call(ctx, "bleed/network/loadbalancer/removeservice", map[string]interface{}{
+		"uniq_id":  uniqId,
+		"src_port": srcPort,
+	})
+}
+
+// PossibleNodes calls bleed/network/loadbalancer/possiblenodes. A region of 0
+// returns possible nodes in every region.
+func (s *NetworkLoadBalancerService) PossibleNodes(ctx context.Context,
+	region int) (*apiTypes.NetworkLoadBalancerPossibleNodes, error) {
+	params := map[string]interface{}{}
+	if region != 0 {
+		params["region"] = region
+	}
+
+	var resp apiTypes.NetworkLoadBalancerPossibleNodes
+	if err := s.client.Call(ctx, "bleed/network/loadbalancer/possiblenodes", params, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// Strategies calls bleed/network/loadbalancer/strategies.
+func (s *NetworkLoadBalancerService) Strategies(ctx context.Context) (*apiTypes.NetworkLoadBalancerStrategies, error) {
+	var resp apiTypes.NetworkLoadBalancerStrategies
+	if err := s.client.Call(ctx, "bleed/network/loadbalancer/strategies", nil, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+func (s *NetworkLoadBalancerService) call(ctx context.Context, method string,
+	params interface{}) (*apiTypes.NetworkLoadBalancerDetails, error) {
+	var resp apiTypes.NetworkLoadBalancerDetails
+	if err := s.client.Call(ctx, method, params, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
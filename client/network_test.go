@@ -0,0 +1,32 @@
+/*
+Copyright © LiquidWeb
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package client
+
+import (
+	"testing"
+)
+
+func TestNetworkPoolCreateRequestZeros(t *testing.T) {
+	var zero int64
+	fields := marshalFields(t, &NetworkPoolCreateRequest{ZoneId: 1, NewIps: &zero})
+	assertFields(t, fields, map[string]interface{}{"new_ips": float64(0)}, "add_ips")
+
+	fields = marshalFields(t, &NetworkPoolCreateRequest{AddIps: []string{"10.0.0.1"}})
+	assertFields(t, fields, map[string]interface{}{
+		"zone_id": float64(0),
+		"add_ips": []interface{}{"10.0.0.1"},
+	}, "new_ips")
+}
@@ -0,0 +1,148 @@
+/*
+Copyright © LiquidWeb
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/liquidweb/liquidweb-cli/types/api"
+	"github.com/liquidweb/liquidweb-cli/types/errors"
+)
+
+// DefaultPageSize is the page size used when ListOptions.PageSize is unset.
+const DefaultPageSize = int64(500)
+
+// ListOptions controls how list methods page through results. None of it is sent
+// to the API as-is.
+type ListOptions struct {
+	// PageSize is the number of results to request per page.
+	PageSize int64 `json:"-"`
+	// Limit stops pagination once this many items have been seen. Zero means no limit.
+	Limit int64 `json:"-"`
+}
+
+// ItemFunc is called once per raw item by Paginate. Returning false stops
+// iteration without fetching any further pages.
+type ItemFunc func(item map[string]interface{}) (more bool, err error)
+
+// Paginate calls fn for every item returned by a paginated API method, fetching one
+// page at a time. params may be a map or any request struct; page_num and page_size
+// are filled in on each call. It is the untyped building block the typed list
+// methods use, and is exported for methods they don't cover.
+func (c *Client) Paginate(ctx context.Context, method string, params interface{}, opts ListOptions,
+	fn ItemFunc) (fetchedPages int64, pageSize int64, err error) {
+
+	if method == "" {
+		err = fmt.Errorf("%w Method", errorTypes.LwCliInputError)
+		return
+	}
+
+	pageSize = DefaultPageSize
+	if opts.PageSize != 0 {
+		pageSize = opts.PageSize
+	}
+	// no sense fetching a bigger page than we will consume
+	if opts.Limit > 0 && opts.Limit < pageSize {
+		pageSize = opts.Limit
+	}
+
+	args, err := toArgs(params)
+	if err != nil {
+		return
+	}
+	args["page_size"] = pageSize
+
+	var seen int64
+	nextPage := int64(1)
+	for {
+		args["page_num"] = nextPage
+
+		var page apiTypes.PaginatedList
+		if err = c.Call(ctx, method, args, &page); err != nil {
+			return
+		}
+		fetchedPages++
+
+		for _, item := range page.Items {
+			var more bool
+			more, err = fn(item)
+			if err != nil || !more {
+				return
+			}
+
+			seen++
+			if opts.Limit > 0 && seen >= opts.Limit {
+				return
+			}
+		}
+
+		nextPage++
+		if nextPage > page.PageTotal {
+			break
+		}
+	}
+
+	return
+}
+
+// toArgs turns a request struct (or map) into a fresh argument map, so paginating
+// never mutates the caller's request.
+func toArgs(params interface{}) (args map[string]interface{}, err error) {
+	args = map[string]interface{}{}
+	if params == nil {
+		return
+	}
+
+	encoded, err := json.Marshal(params)
+	if err != nil {
+		return
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(encoded))
+	decoder.UseNumber()
+	if err = decoder.Decode(&args); err != nil {
+		return
+	}
+	if args == nil {
+		args = map[string]interface{}{}
+	}
+
+	return
+}
+
+// All is like Paginate, but merges every item into a single list.
+func (c *Client) All(ctx context.Context, method string, params interface{},
+	opts ListOptions) (apiTypes.MergedPaginatedList, error) {
+	mergedList := apiTypes.MergedPaginatedList{
+		Items: []map[string]interface{}{},
+	}
+
+	pages, pageSize, err := c.Paginate(ctx, method, params, opts, func(item map[string]interface{}) (bool, error) {
+		mergedList.Items = append(mergedList.Items, item)
+		return true, nil
+	})
+	if err != nil {
+		return apiTypes.MergedPaginatedList{}, err
+	}
+
+	mergedList.MergedPages = pages
+	mergedList.PageSize = pageSize
+
+	return mergedList, nil
+}
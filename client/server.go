@@ -0,0 +1,169 @@
+/*
+Copyright © LiquidWeb
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package client
+
+import (
+	"context"
+
+	"github.com/liquidweb/liquidweb-cli/types/api"
+)
+
+type ServerService struct {
+	client *Client
+}
+
+type ServerCreateRequest struct {
+	Domain       string               `json:"domain"`
+	Type         string               `json:"type"`
+	Zone         int64                `json:"zone"`
+	Password     string               `json:"password"`
+	NewIps       int                  `json:"new_ips"`
+	NewIp6s      int                  `json:"new_ip6s,omitempty"`
+	PoolIps      []string             `json:"pool_ips"`
+	Pool6Ips     []string             `json:"pool6_ips,omitempty"`
+	BackupId     int                  `json:"backup_id,omitempty"`
+	ImageId      int                  `json:"image_id,omitempty"`
+	Parent       string               `json:"parent,omitempty"`
+	Vcpu         int                  `json:"vcpu,omitempty"`
+	Diskspace    int                  `json:"diskspace,omitempty"`
+	Memory       int                  `json:"memory,omitempty"`
+	PublicSshKey string               `json:"public_ssh_key,omitempty"`
+	Features     ServerCreateFeatures `json:"features"`
+}
+
+// ServerCreateFeatures are the features of a new server. BackupQuota is sent whenever
+// it is set, 0 included, as it always is on the Quota backup plan.
+type ServerCreateFeatures struct {
+	Bandwidth string `json:"Bandwidth"`
+	// ConfigId is always sent; it is 0 when creating on a private parent.
+	ConfigId            int                         `json:"ConfigId"`
+	ExtraIp             ServerCreateFeatureOption   `json:"ExtraIp"`
+	LiquidWebBackupPlan string                      `json:"LiquidWebBackupPlan"`
+	BackupQuota         *int                        `json:"BackupQuota,omitempty"`
+	BackupDay           *ServerCreateFeatureBackups `json:"BackupDay,omitempty"`
+	Template            string                      `json:"Template,omitempty"`
+	WinAV               string                      `json:"WinAV,omitempty"`
+	WindowsLicense      string                      `json:"WindowsLicense,omitempty"`
+	MsSQL               *ServerCreateFeatureOption  `json:"MsSQL,omitempty"`
+}
+
+type ServerCreateFeatureOption struct {
+	Value interface{} `json:"value"`
+	Count int         `json:"count"`
+}
+
+type ServerCreateFeatureBackups struct {
+	Value    int `json:"value"`
+	NumUnits int `json:"num_units"`
+}
+
+type ServerCloneRequest struct {
+	UniqId    string   `json:"uniq_id"`
+	Domain    string   `json:"domain"`
+	NewIps    int64    `json:"new_ips"`
+	NewIp6s   int64    `json:"new_ip6s"`
+	Password  string   `json:"password,omitempty"`
+	Zone      int64    `json:"zone,omitempty"`
+	Parent    string   `json:"parent,omitempty"`
+	ConfigId  int64    `json:"config_id,omitempty"`
+	Diskspace int64    `json:"diskspace,omitempty"`
+	Memory    int64    `json:"memory,omitempty"`
+	Vcpu      int64    `json:"vcpu,omitempty"`
+	PoolIps   []string `json:"pool_ips,omitempty"`
+	Pool6Ips  []string `json:"pool6_ips,omitempty"`
+}
+
+type ServerDestroyRequest struct {
+	UniqId              string `json:"uniq_id"`
+	CancellationComment string `json:"cancellation_comment"`
+	CancellationReason  string `json:"cancellation_reason,omitempty"`
+}
+
+type ServerResizeRequest struct {
+	UniqId       string  `json:"uniq_id"`
+	SkipFsResize IntBool `json:"skip_fs_resize"`
+	// NewSize is the config id to resize to; 0 when resizing on a private parent.
+	NewSize   int64  `json:"newsize"`
+	Parent    string `json:"parent,omitempty"`
+	Diskspace int64  `json:"diskspace,omitempty"`
+	Memory    int64  `json:"memory,omitempty"`
+	Vcpu      int64  `json:"vcpu,omitempty"`
+}
+
+// Create calls bleed/server/create.
+func (s *ServerService) Create(ctx context.Context, req *ServerCreateRequest) (*apiTypes.CloudServerCreateResponse, error) {
+	var resp apiTypes.CloudServerCreateResponse
+	if err := s.client.Call(ctx, "bleed/server/create", req, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// Clone calls bleed/server/clone.
+func (s *ServerService) Clone(ctx context.Context, req *ServerCloneRequest) (*apiTypes.CloudServerCloneResponse, error) {
+	var resp apiTypes.CloudServerCloneResponse
+	if err := s.client.Call(ctx, "bleed/server/clone", req, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// Destroy calls bleed/server/destroy.
+func (s *ServerService) Destroy(ctx context.Context,
+	req *ServerDestroyRequest) (*apiTypes.CloudServerDestroyResponse, error) {
+	var resp apiTypes.CloudServerDestroyResponse
+	if err := s.client.Call(ctx, "bleed/server/destroy", req, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// Resize calls bleed/server/resize. Progress is reported by bleed/storm/server/status,
+// so nothing is returned on success.
+func (s *ServerService) Resize(ctx context.Context, req *ServerResizeRequest) error {
+	return s.client.Call(ctx, "bleed/server/resize", req, nil)
+}
+
+// Shutdown calls bleed/server/shutdown.
+func (s *ServerService) Shutdown(ctx context.Context, uniqId string,
+	force bool) (*apiTypes.CloudServerShutdownResponse, error) {
+	params := map[string]interface{}{"uniq_id": uniqId}
+	// only sent when set, to work around the method mishandling force=false.
+	if force {
+		params["force"] = force
+	}
+
+	var resp apiTypes.CloudServerShutdownResponse
+	if err := s.client.Call(ctx, "bleed/server/shutdown", params, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// Start calls bleed/server/start.
+func (s *ServerService) Start(ctx context.Context, uniqId string) (*apiTypes.CloudServerStartResponse, error) {
+	var resp apiTypes.CloudServerStartResponse
+	if err := s.client.Call(ctx, "bleed/server/start", map[string]interface{}{"uniq_id": uniqId},
+		&resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
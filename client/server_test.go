@@ -0,0 +1,115 @@
+/*
+Copyright © LiquidWeb
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package client
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+// marshalFields returns req as the API sees it: its JSON, decoded into a map.
+func marshalFields(t *testing.T, req interface{}) (fields map[string]interface{}) {
+	t.Helper()
+
+	encoded, err := json.Marshal(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := json.Unmarshal(encoded, &fields); err != nil {
+		t.Fatal(err)
+	}
+
+	return
+}
+
+// assertFields checks fields holds want, and none of the keys in absent.
+func assertFields(t *testing.T, fields map[string]interface{}, want map[string]interface{}, absent ...string) {
+	t.Helper()
+
+	for key, value := range want {
+		got, exists := fields[key]
+		if !exists {
+			t.Errorf("%s not sent, want %v", key, value)
+			continue
+		}
+		if !reflect.DeepEqual(got, value) {
+			t.Errorf("%s is %#v, want %#v", key, got, value)
+		}
+	}
+	for _, key := range absent {
+		if got, exists := fields[key]; exists {
+			t.Errorf("%s sent as %#v, want it left out", key, got)
+		}
+	}
+}
+
+func TestServerCreateRequestZeros(t *testing.T) {
+	zero := 0
+	fields := marshalFields(t, &ServerCreateRequest{
+		Domain:  "example.com",
+		PoolIps: []string{},
+		Features: ServerCreateFeatures{
+			LiquidWebBackupPlan: "Quota",
+			BackupQuota:         &zero,
+		},
+	})
+	assertFields(t, fields, map[string]interface{}{
+		"zone":     float64(0),
+		"new_ips":  float64(0),
+		"password": "",
+		"pool_ips": []interface{}{},
+	}, "new_ip6s", "pool6_ips", "backup_id", "image_id", "parent", "vcpu", "diskspace", "memory",
+		"public_ssh_key")
+
+	features, ok := fields["features"].(map[string]interface{})
+	if !ok {
+		t.Fatalf("features is %#v", fields["features"])
+	}
+	assertFields(t, features, map[string]interface{}{
+		"ConfigId":            float64(0),
+		"Bandwidth":           "",
+		"LiquidWebBackupPlan": "Quota",
+		"BackupQuota":         float64(0),
+		"ExtraIp":             map[string]interface{}{"value": nil, "count": float64(0)},
+	}, "BackupDay", "Template", "WinAV", "WindowsLicense", "MsSQL")
+
+	// plans other than Quota leave the quota unset, so it isn't sent
+	fields = marshalFields(t, &ServerCreateRequest{Features: ServerCreateFeatures{LiquidWebBackupPlan: "None"}})
+	assertFields(t, fields, map[string]interface{}{"pool_ips": nil})
+	assertFields(t, fields["features"].(map[string]interface{}), nil, "BackupQuota")
+}
+
+func TestServerCloneRequestZeros(t *testing.T) {
+	fields := marshalFields(t, &ServerCloneRequest{UniqId: "ABC123", Domain: "example.com"})
+	assertFields(t, fields, map[string]interface{}{
+		"new_ips":  float64(0),
+		"new_ip6s": float64(0),
+	}, "password", "zone", "parent", "config_id", "diskspace", "memory", "vcpu", "pool_ips", "pool6_ips")
+}
+
+func TestServerResizeRequestZeros(t *testing.T) {
+	fields := marshalFields(t, &ServerResizeRequest{UniqId: "ABC123", Parent: "DEF456", Vcpu: 2})
+	assertFields(t, fields, map[string]interface{}{
+		"newsize":        float64(0),
+		"skip_fs_resize": float64(0),
+		"parent":         "DEF456",
+		"vcpu":           float64(2),
+	}, "diskspace", "memory")
+
+	fields = marshalFields(t, &ServerResizeRequest{UniqId: "ABC123", NewSize: 7, SkipFsResize: true})
+	assertFields(t, fields, map[string]interface{}{"skip_fs_resize": float64(1)}, "parent")
+}
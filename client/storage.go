@@ -0,0 +1,254 @@
+/*
+Copyright © LiquidWeb
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package client
+
+import (
+	"context"
+
+	"github.com/liquidweb/liquidweb-cli/types/api"
+)
+
+type StorageService struct {
+	Block       *StorageBlockService
+	ObjectStore *StorageObjectStoreService
+}
+
+func newStorageService(c *Client) *StorageService {
+	return &StorageService{
+		Block: &StorageBlockService{
+			Volume: &StorageBlockVolumeService{client: c},
+		},
+		ObjectStore: &StorageObjectStoreService{client: c},
+	}
+}
+
+type StorageBlockService struct {
+	Volume *StorageBlockVolumeService
+}
+
+/* bleed/storage/block/volume */
+
+type StorageBlockVolumeService struct {
+	client *Client
+}
+
+type StorageBlockVolumeCreateRequest struct {
+	Domain      string `json:"domain"`
+	Size        int64  `json:"size"`
+	CrossAttach bool   `json:"cross_attach"`
+	Attach      string `json:"attach,omitempty"`
+	Region      int64  `json:"region,omitempty"`
+	Zone        int64  `json:"zone,omitempty"`
+}
+
+// StorageBlockVolumeUpdateRequest only sends the fields that are set.
+type StorageBlockVolumeUpdateRequest struct {
+	UniqId      string `json:"uniq_id"`
+	Domain      string `json:"domain,omitempty"`
+	CrossAttach *bool  `json:"cross_attach,omitempty"`
+}
+
+// Each calls fn for every volume bleed/storage/block/volume/list returns.
+func (s *StorageBlockVolumeService) Each(ctx context.Context, opts ListOptions,
+	fn func(*apiTypes.CloudBlockStorageVolumeDetails) (bool, error)) error {
+	_, _, err := s.client.Paginate(ctx, "bleed/storage/block/volume/list", nil, opts,
+		func(item map[string]interface{}) (bool, error) {
+			var volume apiTypes.CloudBlockStorageVolumeDetails
+			if err := Decode(item, &volume); err != nil {
+				return false, err
+			}
+			return fn(&volume)
+		})
+
+	return err
+}
+
+// All returns every raw item bleed/storage/block/volume/list returns.
+func (s *StorageBlockVolumeService) All(ctx context.Context, opts ListOptions) (apiTypes.MergedPaginatedList, error) {
+	return s.client.All(ctx, "bleed/storage/block/volume/list", nil, opts)
+}
+
+// Details calls bleed/storage/block/volume/details.
+func (s *StorageBlockVolumeService) Details(ctx context.Context,
+	uniqId string) (*apiTypes.CloudBlockStorageVolumeDetails, error) {
+	var resp apiTypes.CloudBlockStorageVolumeDetails
+	if err := s.client.Call(ctx, "bleed/storage/block/volume/details", map[string]interface{}{"uniq_id": uniqId},
+		&resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// Create calls bleed/storage/block/volume/create.
+func (s *StorageBlockVolumeService) Create(ctx context.Context,
+	req *StorageBlockVolumeCreateRequest) (*apiTypes.CloudBlockStorageVolumeDetails, error) {
+	var resp apiTypes.CloudBlockStorageVolumeDetails
+	if err := s.client.Call(ctx, "bleed/storage/block/volume/create", req, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// Update calls bleed/storage/block/volume/update.
+func (s *StorageBlockVolumeService) Update(ctx context.Context,
+	req *StorageBlockVolumeUpdateRequest) (*apiTypes.CloudBlockStorageVolumeDetails, error) {
+	var resp apiTypes.CloudBlockStorageVolumeDetails
+	if err := s.client.Call(ctx, "bleed/storage/block/volume/update", req, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// Delete calls bleed/storage/block/volume/delete.
+func (s *StorageBlockVolumeService) Delete(ctx context.Context,
+	uniqId string) (*apiTypes.CloudBlockStorageVolumeDelete, error) {
+	var resp apiTypes.CloudBlockStorageVolumeDelete
+	if err := s.client.Call(ctx, "bleed/storage/block/volume/delete", map[string]interface{}{"uniq_id": uniqId},
+		&resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// Attach calls bleed/storage/block/volume/attach, attaching the volume to server to.
+func (s *StorageBlockVolumeService) Attach(ctx context.Context, uniqId string,
+	to string) (*apiTypes.CloudBlockStorageVolumeAttach, error) {
+	params := map[string]interface{}{
+		"uniq_id": uniqId,
+		"to":      to,
+	}
+
+	var resp apiTypes.CloudBlockStorageVolumeAttach
+	if err := s.client.Call(ctx, "bleed/storage/block/volume/attach", params, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// Detach calls bleed/storage/block/volume/detach, detaching the volume from server from.
+func (s *StorageBlockVolumeService) Detach(ctx context.Context, uniqId string,
+	from string) (*apiTypes.CloudBlockStorageVolumeDetach, error) {
+	params := map[string]interface{}{
+		"uniq_id":     uniqId,
+		"detach_from": from,
+	}
+
+	var resp apiTypes.CloudBlockStorageVolumeDetach
+	if err := s.client.Call(ctx, "bleed/storage/block/volume/detach", params, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// Resize calls bleed/storage/block/volume/resize.
+func (s *StorageBlockVolumeService) Resize(ctx context.Context, uniqId string,
+	newSize int64) (*apiTypes.CloudBlockStorageVolumeResize, error) {
+	params := map[string]interface{}{
+		"uniq_id":  uniqId,
+		"new_size": newSize,
+	}
+
+	var resp apiTypes.CloudBlockStorageVolumeResize
+	if err := s.client.Call(ctx, "bleed/storage/block/volume/resize", params, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+/* bleed/storage/objectstore */
+
+type StorageObjectStoreService struct {
+	client *Client
+}
+
+// Each calls fn for every object store on the account. There is no dedicated list
+// method, so this pages through bleed/asset/list.
+func (s *StorageObjectStoreService) Each(ctx context.Context, opts ListOptions,
+	fn func(*apiTypes.Subaccnt) (bool, error)) error {
+	return s.client.Asset.Each(ctx, &AssetListRequest{
+		ListOptions: opts,
+		Type:        "SS.ObjectStore",
+	}, fn)
+}
+
+// Create calls bleed/storage/objectstore/create.
+func (s *StorageObjectStoreService) Create(ctx context.Context) (*apiTypes.CloudObjectStoreDetails, error) {
+	var resp apiTypes.CloudObjectStoreDetails
+	if err := s.client.Call(ctx, "bleed/storage/objectstore/create", nil, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// Details calls bleed/storage/objectstore/details.
+func (s *StorageObjectStoreService) Details(ctx context.Context,
+	uniqId string) (*apiTypes.CloudObjectStoreDetails, error) {
+	var resp apiTypes.CloudObjectStoreDetails
+	if err := s.client.Call(ctx, "bleed/storage/objectstore/details", map[string]interface{}{"uniq_id": uniqId},
+		&resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// Delete calls bleed/storage/objectstore/delete.
+func (s *StorageObjectStoreService) Delete(ctx context.Context,
+	uniqId string) (*apiTypes.CloudObjectStoreDelete, error) {
+	var resp apiTypes.CloudObjectStoreDelete
+	if err := s.client.Call(ctx, "bleed/storage/objectstore/delete", map[string]interface{}{"uniq_id": uniqId},
+		&resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// CreateKey calls bleed/storage/objectstore/createkey.
+func (s *StorageObjectStoreService) CreateKey(ctx context.Context,
+	uniqId string) (*apiTypes.CloudObjectStoreKeyDetails, error) {
+	var resp apiTypes.CloudObjectStoreKeyDetails
+	if err := s.client.Call(ctx, "bleed/storage/objectstore/createkey", map[string]interface{}{"uniq_id": uniqId},
+		&resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// DeleteKey calls bleed/storage/objectstore/deletekey.
+func (s *StorageObjectStoreService) DeleteKey(ctx context.Context, uniqId string,
+	accessKey string) (*apiTypes.CloudObjectStoreDeleteKey, error) {
+	params := map[string]interface{}{
+		"uniq_id":    uniqId,
+		"access_key": accessKey,
+	}
+
+	var resp apiTypes.CloudObjectStoreDeleteKey
+	if err := s.client.Call(ctx, "bleed/storage/objectstore/deletekey", params, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
@@ -0,0 +1,490 @@
+/*
+Copyright © LiquidWeb
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package client
+
+import (
+	"context"
+
+	"github.com/liquidweb/liquidweb-cli/types/api"
+)
+
+type StormService struct {
+	Server        *StormServerService
+	Config        *StormConfigService
+	Template      *StormTemplateService
+	Image         *StormImageService
+	Backup        *StormBackupService
+	PrivateParent *StormPrivateParentService
+}
+
+func newStormService(c *Client) *StormService {
+	return &StormService{
+		Server:        &StormServerService{client: c},
+		Config:        &StormConfigService{client: c},
+		Template:      &StormTemplateService{client: c},
+		Image:         &StormImageService{client: c},
+		Backup:        &StormBackupService{client: c},
+		PrivateParent: &StormPrivateParentService{client: c},
+	}
+}
+
+/* bleed/storm/server */
+
+type StormServerService struct {
+	client *Client
+}
+
+type StormServerUpdateRequest struct {
+	UniqId string `json:"uniq_id"`
+	Domain string `json:"domain,omitempty"`
+	// BandwidthQuota and BackupQuota are sent when set, 0 included.
+	BandwidthQuota *int64 `json:"bandwidth_quota,omitempty"`
+	BackupPlan     string `json:"backup_plan,omitempty"`
+	BackupQuota    *int64 `json:"backup_quota,omitempty"`
+}
+
+type StormServerResizePlanRequest struct {
+	UniqId string `json:"uniq_id"`
+	// ConfigId is always sent; it is 0 when resizing on a private parent.
+	ConfigId      int64  `json:"config_id"`
+	PrivateParent string `json:"private_parent,omitempty"`
+	Disk          int64  `json:"disk,omitempty"`
+	Memory        int64  `json:"memory,omitempty"`
+	Vcpu          int64  `json:"vcpu,omitempty"`
+}
+
+// Each calls fn for every cloud server bleed/storm/server/list returns.
+func (s *StormServerService) Each(ctx context.Context, opts ListOptions,
+	fn func(*apiTypes.CloudServerDetails) (bool, error)) error {
+	_, _, err := s.client.Paginate(ctx, "bleed/storm/server/list", nil, opts,
+		func(item map[string]interface{}) (bool, error) {
+			var server apiTypes.CloudServerDetails
+			if err := Decode(item, &server); err != nil {
+				return false, err
+			}
+			return fn(&server)
+		})
+
+	return err
+}
+
+// All returns every raw item bleed/storm/server/list returns.
+func (s *StormServerService) All(ctx context.Context, opts ListOptions) (apiTypes.MergedPaginatedList, error) {
+	return s.client.All(ctx, "bleed/storm/server/list", nil, opts)
+}
+
+// Details calls bleed/storm/server/details.
+func (s *StormServerService) Details(ctx context.Context, uniqId string) (*apiTypes.CloudServerDetails, error) {
+	var resp apiTypes.CloudServerDetails
+	if err := s.client.Call(ctx, "bleed/storm/server/details", map[string]interface{}{"uniq_id": uniqId},
+		&resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// Status calls bleed/storm/server/status.
+func (s *StormServerService) Status(ctx context.Context, uniqId string) (*apiTypes.CloudServerStatus, error) {
+	var resp apiTypes.CloudServerStatus
+	if err := s.client.Call(ctx, "bleed/storm/server/status", map[string]interface{}{"uniq_id": uniqId},
+		&resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// Reboot calls bleed/storm/server/reboot.
+func (s *StormServerService) Reboot(ctx context.Context, uniqId string,
+	force bool) (*apiTypes.CloudServerRebootResponse, error) {
+	params := map[string]interface{}{
+		"uniq_id": uniqId,
+		"force":   IntBool(force),
+	}
+
+	var resp apiTypes.CloudServerRebootResponse
+	if err := s.client.Call(ctx, "bleed/storm/server/reboot", params, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// Update calls bleed/storm/server/update.
+func (s *StormServerService) Update(ctx context.Context,
+	req *StormServerUpdateRequest) (*apiTypes.CloudServerDetails, error) {
+	var resp apiTypes.CloudServerDetails
+	if err := s.client.Call(ctx, "bleed/storm/server/update", req, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// ResizePlan calls bleed/storm/server/resizePlan.
+func (s *StormServerService) ResizePlan(ctx context.Context,
+	req *StormServerResizePlanRequest) (*apiTypes.CloudServerResizeExpectation, error) {
+	var resp apiTypes.CloudServerResizeExpectation
+	if err := s.client.Call(ctx, "bleed/storm/server/resizePlan", req, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// IsBlockStorageOptimized calls bleed/storm/server/issbsoptimized.
+func (s *StormServerService) IsBlockStorageOptimized(ctx context.Context,
+	uniqId string) (*apiTypes.CloudServerIsBlockStorageOptimized, error) {
+	var resp apiTypes.CloudServerIsBlockStorageOptimized
+	if err := s.client.Call(ctx, "bleed/storm/server/issbsoptimized", map[string]interface{}{"uniq_id": uniqId},
+		&resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// SetBlockStorageOptimized calls bleed/storm/server/setsbsoptimized.
+func (s *StormServerService) SetBlockStorageOptimized(ctx context.Context, uniqId string,
+	value bool) (*apiTypes.CloudServerIsBlockStorageOptimizedSetResponse, error) {
+	params := map[string]interface{}{
+		"uniq_id": uniqId,
+		"value":   value,
+	}
+
+	var resp apiTypes.CloudServerIsBlockStorageOptimizedSetResponse
+	if err := s.client.Call(ctx, "bleed/storm/server/setsbsoptimized", params, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+/* bleed/storm/config */
+
+type StormConfigService struct {
+	client *Client
+}
+
+type StormConfigListRequest struct {
+	ListOptions
+	Category  string `json:"category,omitempty"`
+	Available int64  `json:"available,omitempty"`
+}
+
+// Details calls bleed/storm/config/details.
+func (s *StormConfigService) Details(ctx context.Context, id int64) (*apiTypes.CloudConfigDetails, error) {
+	var resp apiTypes.CloudConfigDetails
+	if err := s.client.Call(ctx, "bleed/storm/config/details", map[string]interface{}{"id": id},
+		&resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// Each calls fn for every config bleed/storm/config/list returns.
+func (s *StormConfigService) Each(ctx context.Context, req *StormConfigListRequest,
+	fn func(*apiTypes.CloudConfigDetails) (bool, error)) error {
+	_, _, err := s.client.Paginate(ctx, "bleed/storm/config/list", req, req.ListOptions,
+		func(item map[string]interface{}) (bool, error) {
+			var config apiTypes.CloudConfigDetails
+			if err := Decode(item, &config); err != nil {
+				return false, err
+			}
+			return fn(&config)
+		})
+
+	return err
+}
+
+/* bleed/storm/template */
+
+type StormTemplateService struct {
+	client *Client
+}
+
+// Each calls fn for every template bleed/storm/template/list returns.
+func (s *StormTemplateService) Each(ctx context.Context, opts ListOptions,
+	fn func(*apiTypes.CloudTemplateDetails) (bool, error)) error {
+	_, _, err := s.client.Paginate(ctx, "bleed/storm/template/list", nil, opts,
+		func(item map[string]interface{}) (bool, error) {
+			var template apiTypes.CloudTemplateDetails
+			if err := Decode(item, &template); err != nil {
+				return false, err
+			}
+			return fn(&template)
+		})
+
+	return err
+}
+
+// Restore calls bleed/storm/template/restore.
+func (s *StormTemplateService) Restore(ctx context.Context, uniqId string,
+	template string) (*apiTypes.CloudTemplateRestoreResponse, error) {
+	params := map[string]interface{}{
+		"uniq_id":  uniqId,
+		"template": template,
+	}
+
+	var resp apiTypes.CloudTemplateRestoreResponse
+	if err := s.client.Call(ctx, "bleed/storm/template/restore", params, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+/* bleed/storm/image */
+
+type StormImageService struct {
+	client *Client
+}
+
+// Each calls fn for every image bleed/storm/image/list returns.
+func (s *StormImageService) Each(ctx context.Context, opts ListOptions,
+	fn func(*apiTypes.CloudImageDetails) (bool, error)) error {
+	_, _, err := s.client.Paginate(ctx, "bleed/storm/image/list", nil, opts,
+		func(item map[string]interface{}) (bool, error) {
+			var image apiTypes.CloudImageDetails
+			if err := Decode(item, &image); err != nil {
+				return false, err
+			}
+			return fn(&image)
+		})
+
+	return err
+}
+
+// All returns every raw item bleed/storm/image/list returns.
+func (s *StormImageService) All(ctx context.Context, opts ListOptions) (apiTypes.MergedPaginatedList, error) {
+	return s.client.All(ctx, "bleed/storm/image/list", nil, opts)
+}
+
+// Details calls bleed/storm/image/details.
+func (s *StormImageService) Details(ctx context.Context, id int64) (*apiTypes.CloudImageDetails, error) {
+	var resp apiTypes.CloudImageDetails
+	if err := s.client.Call(ctx, "bleed/storm/image/details", map[string]interface{}{"id": id},
+		&resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// Create calls bleed/storm/image/create, imaging the server uniqId.
+func (s *StormImageService) Create(ctx context.Context, uniqId string,
+	name string) (*apiTypes.CloudImageCreateResponse, error) {
+	params := map[string]interface{}{
+		"uniq_id": uniqId,
+		"name":    name,
+	}
+
+	var resp apiTypes.CloudImageCreateResponse
+	if err := s.client.Call(ctx, "bleed/storm/image/create", params, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// Delete calls bleed/storm/image/delete.
+func (s *StormImageService) Delete(ctx context.Context, id int64) (*apiTypes.CloudImageDeleteResponse, error) {
+	var resp apiTypes.CloudImageDeleteResponse
+	if err := s.client.Call(ctx, "bleed/storm/image/delete", map[string]interface{}{"id": id},
+		&resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// Update calls bleed/storm/image/update. Only the name can be changed.
+func (s *StormImageService) Update(ctx context.Context, id int64, name string) (*apiTypes.CloudImageDetails, error) {
+	params := map[string]interface{}{
+		"id":   id,
+		"name": name,
+	}
+
+	var resp apiTypes.CloudImageDetails
+	if err := s.client.Call(ctx, "bleed/storm/image/update", params, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// Restore calls bleed/storm/image/restore, restoring image id onto server uniqId.
+// force rebuilds the filesystem.
+func (s *StormImageService) Restore(ctx context.Context, id int64, uniqId string,
+	force bool) (*apiTypes.CloudImageRestoreResponse, error) {
+	params := map[string]interface{}{
+		"id":      id,
+		"uniq_id": uniqId,
+	}
+	if force {
+		params["force"] = IntBool(force)
+	}
+
+	var resp apiTypes.CloudImageRestoreResponse
+	if err := s.client.Call(ctx, "bleed/storm/image/restore", params, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+/* bleed/storm/backup */
+
+type StormBackupService struct {
+	client *Client
+}
+
+// Each calls fn for every backup bleed/storm/backup/list returns.
+func (s *StormBackupService) Each(ctx context.Context, opts ListOptions,
+	fn func(*apiTypes.CloudBackupDetails) (bool, error)) error {
+	_, _, err := s.client.Paginate(ctx, "bleed/storm/backup/list", nil, opts,
+		func(item map[string]interface{}) (bool, error) {
+			var backup apiTypes.CloudBackupDetails
+			if err := Decode(item, &backup); err != nil {
+				return false, err
+			}
+			return fn(&backup)
+		})
+
+	return err
+}
+
+// All returns every raw item bleed/storm/backup/list returns.
+func (s *StormBackupService) All(ctx context.Context, opts ListOptions) (apiTypes.MergedPaginatedList, error) {
+	return s.client.All(ctx, "bleed/storm/backup/list", nil, opts)
+}
+
+// Details calls bleed/storm/backup/details.
+func (s *StormBackupService) Details(ctx context.Context, id int64) (*apiTypes.CloudBackupDetails, error) {
+	var resp apiTypes.CloudBackupDetails
+	if err := s.client.Call(ctx, "bleed/storm/backup/details", map[string]interface{}{"id": id},
+		&resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// Restore calls bleed/storm/backup/restore, restoring backup id onto server uniqId.
+// force rebuilds the filesystem.
+func (s *StormBackupService) Restore(ctx context.Context, id int64, uniqId string,
+	force bool) (*apiTypes.CloudBackupRestoreResponse, error) {
+	params := map[string]interface{}{
+		"id":      id,
+		"uniq_id": uniqId,
+	}
+	if force {
+		params["force"] = IntBool(force)
+	}
+
+	var resp apiTypes.CloudBackupRestoreResponse
+	if err := s.client.Call(ctx, "bleed/storm/backup/restore", params, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+/* bleed/storm/private/parent */
+
+type StormPrivateParentService struct {
+	client *Client
+}
+
+type StormPrivateParentCreateRequest struct {
+	Domain   string `json:"domain"`
+	ConfigId int64  `json:"config_id"`
+	Zone     int64  `json:"zone"`
+}
+
+// Each calls fn for every private parent bleed/storm/private/parent/list returns.
+func (s *StormPrivateParentService) Each(ctx context.Context, opts ListOptions,
+	fn func(*apiTypes.CloudPrivateParentDetails) (bool, error)) error {
+	_, _, err := s.client.Paginate(ctx, "bleed/storm/private/parent/list", nil, opts,
+		func(item map[string]interface{}) (bool, error) {
+			var privateParent apiTypes.CloudPrivateParentDetails
+			if err := Decode(item, &privateParent); err != nil {
+				return false, err
+			}
+			return fn(&privateParent)
+		})
+
+	return err
+}
+
+// All returns every raw item bleed/storm/private/parent/list returns.
+func (s *StormPrivateParentService) All(ctx context.Context,
+	opts ListOptions) (apiTypes.MergedPaginatedList, error) {
+	return s.client.All(ctx, "bleed/storm/private/parent/list", nil, opts)
+}
+
+// Details calls bleed/storm/private/parent/details.
+func (s *StormPrivateParentService) Details(ctx context.Context,
+	uniqId string) (*apiTypes.CloudPrivateParentDetails, error) {
+	var resp apiTypes.CloudPrivateParentDetails
+	if err := s.client.Call(ctx, "bleed/storm/private/parent/details", map[string]interface{}{"uniq_id": uniqId},
+		&resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// Create calls bleed/storm/private/parent/create.
+func (s *StormPrivateParentService) Create(ctx context.Context,
+	req *StormPrivateParentCreateRequest) (*apiTypes.CloudPrivateParentDetails, error) {
+	var resp apiTypes.CloudPrivateParentDetails
+	if err := s.client.Call(ctx, "bleed/storm/private/parent/create", req, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// Update calls bleed/storm/private/parent/update. Only the name (domain) can be changed.
+func (s *StormPrivateParentService) Update(ctx context.Context, uniqId string,
+	domain string) (*apiTypes.CloudPrivateParentDetails, error) {
+	params := map[string]interface{}{
+		"uniq_id": uniqId,
+		"domain":  domain,
+	}
+
+	var resp apiTypes.CloudPrivateParentDetails
+	if err := s.client.Call(ctx, "bleed/storm/private/parent/update", params, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// Delete calls bleed/storm/private/parent/delete.
+func (s *StormPrivateParentService) Delete(ctx context.Context,
+	uniqId string) (*apiTypes.CloudPrivateParentDeleteResponse, error) {
+	var resp apiTypes.CloudPrivateParentDeleteResponse
+	if err := s.client.Call(ctx, "bleed/storm/private/parent/delete", map[string]interface{}{"uniq_id": uniqId},
+		&resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
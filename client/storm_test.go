@@ -0,0 +1,39 @@
+/*
+Copyright © LiquidWeb
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package client
+
+import (
+	"testing"
+)
+
+func TestStormServerUpdateRequestZeros(t *testing.T) {
+	var zero int64
+	fields := marshalFields(t, &StormServerUpdateRequest{
+		UniqId:         "ABC123",
+		BandwidthQuota: &zero,
+		BackupPlan:     "Quota",
+		BackupQuota:    &zero,
+	})
+	assertFields(t, fields, map[string]interface{}{
+		"bandwidth_quota": float64(0),
+		"backup_quota":    float64(0),
+	}, "domain")
+
+	// quotas not given aren't changed, so aren't sent
+	fields = marshalFields(t, &StormServerUpdateRequest{UniqId: "ABC123", Domain: "example.com"})
+	assertFields(t, fields, map[string]interface{}{"domain": "example.com"}, "bandwidth_quota",
+		"backup_plan", "backup_quota")
+}
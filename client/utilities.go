@@ -0,0 +1,46 @@
+/*
+Copyright © LiquidWeb
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package client
+
+import (
+	"context"
+
+	"github.com/liquidweb/liquidweb-cli/types/api"
+)
+
+type UtilitiesService struct {
+	Info *UtilitiesInfoService
+}
+
+func newUtilitiesService(c *Client) *UtilitiesService {
+	return &UtilitiesService{
+		Info: &UtilitiesInfoService{client: c},
+	}
+}
+
+type UtilitiesInfoService struct {
+	client *Client
+}
+
+// Ping calls bleed/utilities/info/ping.
+func (s *UtilitiesInfoService) Ping(ctx context.Context) (*apiTypes.UtilitiesInfoPingResponse, error) {
+	var resp apiTypes.UtilitiesInfoPingResponse
+	if err := s.client.Call(ctx, "bleed/utilities/info/ping", nil, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
@@ -0,0 +1,93 @@
+/*
+Copyright © LiquidWeb
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package client
+
+import (
+	"context"
+
+	"github.com/liquidweb/liquidweb-cli/types/api"
+)
+
+type VipService struct {
+	client *Client
+}
+
+type VipCreateRequest struct {
+	Domain string `json:"domain"`
+	Zone   int64  `json:"zone"`
+}
+
+// vipListRequest is the bleed/asset/list request VIP listings are built on; there
+// is no dedicated bleed/vip/list.
+func vipListRequest(opts ListOptions) *AssetListRequest {
+	return &AssetListRequest{
+		ListOptions: opts,
+		Type:        "SS.VIP",
+		AlsoWith:    []string{"zone"},
+	}
+}
+
+// Create calls bleed/vip/create.
+func (s *VipService) Create(ctx context.Context, req *VipCreateRequest) (*apiTypes.CloudNetworkVipDetails, error) {
+	var resp apiTypes.CloudNetworkVipDetails
+	if err := s.client.Call(ctx, "bleed/vip/create", req, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// Destroy calls bleed/vip/destroy.
+func (s *VipService) Destroy(ctx context.Context, uniqId string) (*apiTypes.CloudNetworkVipDestroyResponse, error) {
+	var resp apiTypes.CloudNetworkVipDestroyResponse
+	if err := s.client.Call(ctx, "bleed/vip/destroy", map[string]interface{}{"uniq_id": uniqId},
+		&resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// Details calls bleed/vip/details.
+func (s *VipService) Details(ctx context.Context, uniqId string) (*apiTypes.CloudNetworkVipDetails, error) {
+	var resp apiTypes.CloudNetworkVipDetails
+	if err := s.client.Call(ctx, "bleed/vip/details", map[string]interface{}{"uniq_id": uniqId},
+		&resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// Each calls fn for every VIP on the account, including its zone.
+func (s *VipService) Each(ctx context.Context, opts ListOptions,
+	fn func(*apiTypes.CloudNetworkVipAssetListAlsoWithZoneResponse) (bool, error)) error {
+	_, _, err := s.client.Paginate(ctx, "bleed/asset/list", vipListRequest(opts), opts,
+		func(item map[string]interface{}) (bool, error) {
+			var vip apiTypes.CloudNetworkVipAssetListAlsoWithZoneResponse
+			if err := Decode(item, &vip); err != nil {
+				return false, err
+			}
+			return fn(&vip)
+		})
+
+	return err
+}
+
+// All returns every VIP on the account as raw items.
+func (s *VipService) All(ctx context.Context, opts ListOptions) (apiTypes.MergedPaginatedList, error) {
+	return s.client.All(ctx, "bleed/asset/list", vipListRequest(opts), opts)
+}
@@ -0,0 +1,174 @@
+/*
+Copyright © LiquidWeb
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/liquidweb/liquidweb-cli/instance"
+	"github.com/liquidweb/liquidweb-cli/output"
+)
+
+var apiCallCmdArgFlag []string
+
+var apiCallCmd = &cobra.Command{
+	Use:   "call <method>",
+	Short: "Call an API method and print its JSON result",
+	Long: `Call an API method and print its JSON result.
+
+The method is given as it appears in the API documentation, for example
+'bleed/storm/server/details':
+
+https://cart.liquidweb.com/storm/api/docs/bleed/
+
+Method arguments can be read from a JSON object with --args-file ('-' reads
+from stdin) and/or given one at a time with --arg. Each --arg value is parsed
+as JSON when possible, so numbers, true/false, lists and objects are sent as
+such; anything else is sent as a string. Quote a value to force a string, for
+example --arg 'domain="1234"'. An --arg overrides the same key in --args-file.
+
+Pass --paginate for list methods to fetch and merge every page of results.
+
+Examples:
+
+'api call bleed/storm/server/details --arg uniq_id=ABC123'
+'api call bleed/network/ip/list --arg uniq_id=ABC123 --arg expand_ips=1 --paginate'
+'api call bleed/storm/server/update --args-file update.json'
+`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		argsFileFlag, _ := cmd.Flags().GetString("args-file")
+		paginateFlag, _ := cmd.Flags().GetBool("paginate")
+
+		method := strings.Trim(args[0], "/")
+		if method == "" {
+			lwCliInst.Die(fmt.Errorf("method cannot be empty"))
+		}
+
+		methodArgs := map[string]interface{}{}
+		if argsFileFlag != "" {
+			fileArgs, err := readApiCallArgsFile(argsFileFlag)
+			if err != nil {
+				lwCliInst.Die(err)
+			}
+			methodArgs = fileArgs
+		}
+
+		for _, arg := range apiCallCmdArgFlag {
+			key, value, err := parseApiCallArg(arg)
+			if err != nil {
+				lwCliInst.Die(err)
+			}
+			methodArgs[key] = value
+		}
+
+		// api results have no text form of their own, so they default to json.
+		printer := newPrinter(cmd)
+		if printer.Format == output.Text {
+			printer.Format = output.Json
+		}
+
+		var result interface{}
+		if paginateFlag {
+			results, err := lwCliInst.AllPaginatedResults(&instance.AllPaginatedResultsArgs{
+				Method:         method,
+				MethodArgs:     methodArgs,
+				ResultsPerPage: 100,
+			})
+			if err != nil {
+				lwCliInst.Die(err)
+			}
+			result = results
+			if printer.Format.Itemized() {
+				result = results.Items
+			}
+		} else {
+			got, err := lwCliInst.LwCliApiClient.Call(method, methodArgs)
+			if err != nil {
+				lwCliInst.Die(err)
+			}
+			result = got
+		}
+
+		if err := printer.Print(result); err != nil {
+			lwCliInst.Die(err)
+		}
+		if err := printer.Flush(); err != nil {
+			lwCliInst.Die(err)
+		}
+	},
+}
+
+func init() {
+	apiCmd.AddCommand(apiCallCmd)
+
+	apiCallCmd.Flags().StringArrayVar(&apiCallCmdArgFlag, "arg", []string{},
+		"method argument as key=value. Can be given multiple times")
+	apiCallCmd.Flags().String("args-file", "", "JSON file holding an object of method arguments ('-' for stdin)")
+	apiCallCmd.Flags().Bool("paginate", false, "fetch every page of a list method and merge the results")
+}
+
+// parseApiCallArg splits a --arg key=value pair, decoding the value as JSON when it
+// is valid JSON and keeping it as a string otherwise.
+func parseApiCallArg(arg string) (key string, value interface{}, err error) {
+	parts := strings.SplitN(arg, "=", 2)
+	if len(parts) != 2 || parts[0] == "" {
+		err = fmt.Errorf("--arg [%s] must be in the form key=value", arg)
+		return
+	}
+	key = parts[0]
+
+	decoder := json.NewDecoder(strings.NewReader(parts[1]))
+	decoder.UseNumber()
+	if decodeErr := decoder.Decode(&value); decodeErr != nil || decoder.More() {
+		value = parts[1]
+	}
+
+	return
+}
+
+func readApiCallArgsFile(file string) (args map[string]interface{}, err error) {
+	var contents []byte
+	if file == "-" {
+		contents, err = ioutil.ReadAll(os.Stdin)
+	} else {
+		contents, err = ioutil.ReadFile(filepath.Clean(file))
+	}
+	if err != nil {
+		err = fmt.Errorf("error reading --args-file [%s]: %s", file, err)
+		return
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(contents))
+	decoder.UseNumber()
+	if err = decoder.Decode(&args); err != nil {
+		err = fmt.Errorf("--args-file [%s] must hold a JSON object of method arguments: %s", file, err)
+		return
+	}
+	if args == nil {
+		args = map[string]interface{}{}
+	}
+
+	return
+}
@@ -16,16 +16,11 @@ limitations under the License.
 package cmd
 
 import (
-	"fmt"
-
 	"github.com/spf13/cobra"
 
-	"github.com/liquidweb/liquidweb-cli/types/api"
 	"github.com/liquidweb/liquidweb-cli/validate"
 )
 
-var assetDetailsCmdUniqIdFlag []string
-
 var assetDetailsCmd = &cobra.Command{
 	Use:   "details",
 	Short: "Get details of a specific asset",
@@ -34,48 +29,28 @@ var assetDetailsCmd = &cobra.Command{
 An asset is an individual component on an account. Assets have categories.
 `,
 	Run: func(cmd *cobra.Command, args []string) {
-		jsonFlag, _ := cmd.Flags().GetBool("json")
-
-		for _, uniqId := range assetDetailsCmdUniqIdFlag {
+		printer := newPrinter(cmd)
+		runPrintedTargets(printer, requireTargets(cmd), func(uniqId string) error {
 			validateFields := map[interface{}]interface{}{
 				uniqId: "UniqId",
 			}
 			if err := validate.Validate(validateFields); err != nil {
-				fmt.Printf("%s ... skipping\n", err)
-				continue
+				return err
 			}
 
-			var details apiTypes.Subaccnt
-			apiArgs := map[string]interface{}{
-				"uniq_id":  uniqId,
-				"alsowith": []string{"categories"},
+			details, err := lwCliInst.Api.Asset.Details(lwCliInst.Context(), uniqId, "categories")
+			if err != nil {
+				return err
 			}
 
-			if err := lwCliInst.CallLwApiInto("bleed/asset/details", apiArgs, &details); err != nil {
-				lwCliInst.Die(err)
-			}
-
-			if jsonFlag {
-				pretty, err := lwCliInst.JsonEncodeAndPrettyPrint(details)
-				if err != nil {
-					lwCliInst.Die(err)
-				}
-				fmt.Print(pretty)
-			} else {
-				fmt.Print(details)
-			}
-		}
+			return printer.Print(details)
+		})
 	},
 }
 
 func init() {
 	assetCmd.AddCommand(assetDetailsCmd)
 
-	assetDetailsCmd.Flags().Bool("json", false, "output in json format")
-	assetDetailsCmd.Flags().StringSliceVar(&assetDetailsCmdUniqIdFlag, "uniq-id", []string{},
-		"uniq-id of the asset. For multiple, must be ',' separated")
-
-	if err := assetDetailsCmd.MarkFlagRequired("uniq-id"); err != nil {
-		lwCliInst.Die(err)
-	}
+	assetDetailsCmd.Flags().Bool("json", false, "output in json format (same as --output json)")
+	addTargetFlags(assetDetailsCmd, "uniq-id of the asset")
 }
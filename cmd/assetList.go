@@ -20,7 +20,8 @@ import (
 
 	"github.com/spf13/cobra"
 
-	"github.com/liquidweb/liquidweb-cli/instance"
+	lwClient "github.com/liquidweb/liquidweb-cli/client"
+	"github.com/liquidweb/liquidweb-cli/output"
 	apiTypes "github.com/liquidweb/liquidweb-cli/types/api"
 )
 
@@ -42,45 +43,54 @@ Examples:
 -  lw-cli asset list --categories StrictDedicated
 `,
 	Run: func(cmd *cobra.Command, args []string) {
-		jsonFlag, _ := cmd.Flags().GetBool("json")
-
-		apiArgs := map[string]interface{}{
-			"alsowith": []string{"categories"},
+		listQuery := newListQuery(cmd)
+
+		listReq := &lwClient.AssetListRequest{
+			ListOptions: lwClient.ListOptions{
+				PageSize: 100,
+				Limit:    listQuery.ListLimit(),
+			},
+			Category: assetListCmdCategoriesFlag,
+			AlsoWith: []string{"categories"},
 		}
 
-		if len(assetListCmdCategoriesFlag) > 0 {
-			apiArgs["category"] = assetListCmdCategoriesFlag
+		printer := newListPrinter(cmd)
+		if printer.Format.Encoded() {
+			results, err := lwCliInst.Api.Asset.All(lwCliInst.Context(), listReq)
+			if err != nil {
+				lwCliInst.Die(err)
+			}
+			if results.Items, err = listQuery.Items(results.Items); err != nil {
+				lwCliInst.Die(err)
+			}
+			if err := printer.Print(results); err != nil {
+				lwCliInst.Die(err)
+			}
+			return
 		}
 
-		methodArgs := instance.AllPaginatedResultsArgs{
-			Method:         "bleed/asset/list",
-			ResultsPerPage: 100,
-			MethodArgs:     apiArgs,
-		}
-		results, err := lwCliInst.AllPaginatedResults(&methodArgs)
+		cnt := 1
+		err := listQuery.Run(func(visit output.ItemFunc) error {
+			return lwCliInst.Api.Asset.Each(lwCliInst.Context(), listReq, func(details *apiTypes.Subaccnt) (bool, error) {
+				return visit(details)
+			})
+		}, func(item interface{}) (bool, error) {
+			details := item.(*apiTypes.Subaccnt)
+			if printer.Format != output.Text {
+				return true, printer.Add(details)
+			}
+
+			fmt.Printf("%d.) ", cnt)
+			fmt.Print(details)
+			cnt++
+
+			return true, nil
+		})
 		if err != nil {
 			lwCliInst.Die(err)
 		}
-
-		if jsonFlag {
-			pretty, err := lwCliInst.JsonEncodeAndPrettyPrint(results)
-			if err != nil {
-				lwCliInst.Die(err)
-			}
-			fmt.Print(pretty)
-		} else {
-			cnt := 1
-			for _, item := range results.Items {
-
-				var details apiTypes.Subaccnt
-				if err := instance.CastFieldTypes(item, &details); err != nil {
-					lwCliInst.Die(err)
-				}
-
-				fmt.Printf("%d.) ", cnt)
-				fmt.Print(details)
-				cnt++
-			}
+		if err := printer.Flush(); err != nil {
+			lwCliInst.Die(err)
 		}
 	},
 }
@@ -88,7 +98,9 @@ Examples:
 func init() {
 	assetCmd.AddCommand(assetListCmd)
 
-	assetListCmd.Flags().Bool("json", false, "output in json format")
+	assetListCmd.Flags().Bool("json", false, "output in json format (same as --output json)")
+	assetListCmd.Flags().Int64("limit", 0, "stop after listing this many assets (0 for no limit)")
+	addListQueryFlags(assetListCmd)
 
 	assetListCmd.Flags().StringSliceVar(&assetListCmdCategoriesFlag, "categories",
 		[]string{}, "categories to include separated by ','")
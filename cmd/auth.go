@@ -16,9 +16,19 @@ limitations under the License.
 package cmd
 
 import (
+	"fmt"
 	"os"
+	"path/filepath"
+	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
+
+	lwCliInstApi "github.com/liquidweb/liquidweb-cli/instance/api"
+	"github.com/liquidweb/liquidweb-cli/secrets"
+	"github.com/liquidweb/liquidweb-cli/types/cmd"
+	"github.com/liquidweb/liquidweb-cli/types/errors"
+	"github.com/liquidweb/liquidweb-cli/utils"
 )
 
 var authCmd = &cobra.Command{
@@ -39,3 +49,193 @@ Test your LiquidWeb API credentials.`,
 func init() {
 	rootCmd.AddCommand(authCmd)
 }
+
+// addPasswordStoreFlags adds the flags choosing where the password or token of an auth
+// context is kept.
+func addPasswordStoreFlags(cmd *cobra.Command, storeDefault string) {
+	cmd.Flags().String("password-store", storeDefault, fmt.Sprintf(
+		"where to keep the password or token; one of %s. auto is the keyring when available, else the vault",
+		strings.Join(secrets.Stores, ", ")))
+	cmd.Flags().String("password-command", "",
+		"command printing the password or token, such as 'pass show liquidweb'. Implies --password-store command")
+}
+
+// addTokenFlags adds the flags choosing how an auth context authenticates, and the
+// API token it authenticates with.
+func addTokenFlags(cmd *cobra.Command, authTypeDefault string) {
+	cmd.Flags().String("auth-type", authTypeDefault, fmt.Sprintf("how to authenticate; %s or %s. --token implies %s",
+		cmdTypes.AuthTypePassword, cmdTypes.AuthTypeToken, cmdTypes.AuthTypeToken))
+	cmd.Flags().String("token", "", "API token to authenticate with")
+	cmd.Flags().String("token-expires", "", "when the API token expires, as YYYY-MM-DD or an RFC 3339 time")
+}
+
+// validateAuthType checks authType names a way auth contexts can authenticate.
+func validateAuthType(authType string) error {
+	if authType != cmdTypes.AuthTypePassword && authType != cmdTypes.AuthTypeToken {
+		return fmt.Errorf("%w: --auth-type must be %s or %s, not [%s]", errorTypes.LwCliInvalidFlagValue,
+			cmdTypes.AuthTypePassword, cmdTypes.AuthTypeToken, authType)
+	}
+
+	return nil
+}
+
+// parseTokenExpires returns the RFC 3339 form of a --token-expires value, given as a
+// date or an RFC 3339 time. A date means the end of that day, UTC.
+func parseTokenExpires(expires string) (string, error) {
+	if expires == "" {
+		return "", nil
+	}
+	if at, err := time.Parse(time.RFC3339, expires); err == nil {
+		return at.Format(time.RFC3339), nil
+	}
+	day, err := time.Parse("2006-01-02", expires)
+	if err != nil {
+		return "", fmt.Errorf("%w: --token-expires must be YYYY-MM-DD or an RFC 3339 time, not [%s]",
+			errorTypes.LwCliInvalidFlagValue, expires)
+	}
+
+	return day.Add(24*time.Hour - time.Second).Format(time.RFC3339), nil
+}
+
+// describeTokenExpiry returns when the token of authContext expires, and how far off
+// that is, for display. expired is set when the token is past its expiry.
+func describeTokenExpiry(authContext cmdTypes.AuthContext) (description string, expired bool) {
+	if authContext.TokenExpires == "" {
+		return "unknown", false
+	}
+	at, err := time.Parse(time.RFC3339, authContext.TokenExpires)
+	if err != nil {
+		return authContext.TokenExpires, false
+	}
+
+	left := time.Until(at)
+	days := int(left.Hours() / 24)
+	switch {
+	case left <= 0:
+		return fmt.Sprintf("%s (EXPIRED)", authContext.TokenExpires), true
+	case days == 0:
+		return fmt.Sprintf("%s (today)", authContext.TokenExpires), false
+	case days == 1:
+		return fmt.Sprintf("%s (in 1 day)", authContext.TokenExpires), false
+	}
+
+	return fmt.Sprintf("%s (in %d days)", authContext.TokenExpires, days), false
+}
+
+// printAuthContextAuthType prints how authContext authenticates, indented as in
+// "auth get-contexts".
+func printAuthContextAuthType(authContext cmdTypes.AuthContext) {
+	if !authContext.UsesToken() {
+		fmt.Printf("\tAuth Type: %s\n", cmdTypes.AuthTypePassword)
+		return
+	}
+
+	fmt.Printf("\tAuth Type: %s\n", cmdTypes.AuthTypeToken)
+	if expiry, expired := describeTokenExpiry(authContext); expired {
+		utils.PrintRed("\tToken Expires: %s\n", expiry)
+	} else {
+		fmt.Printf("\tToken Expires: %s\n", expiry)
+	}
+	if authContext.TokenRotated != "" {
+		fmt.Printf("\tToken Rotated: %s\n", authContext.TokenRotated)
+	}
+}
+
+// storeAuthContextSecret saves the password or token of authContext in its password
+// store, resolving an auto store first. The secret is only left on authContext when it
+// is kept in plaintext.
+func storeAuthContextSecret(authContext *cmdTypes.AuthContext) error {
+	store, err := secrets.Resolve(authContext.PasswordStore)
+	if err != nil {
+		return fmt.Errorf("%w: --password-store: %s", errorTypes.LwCliInvalidFlagValue, err)
+	}
+	authContext.PasswordStore = store
+
+	switch store {
+	case secrets.Plaintext:
+		authContext.PasswordCommand = ""
+		return nil
+	case secrets.Command:
+		if authContext.PasswordCommand == "" {
+			return fmt.Errorf("%w: --password-store command needs --password-command", errorTypes.LwCliInputError)
+		}
+		if authContext.Secret() != "" {
+			return fmt.Errorf("%w: --password or --token can't be given along with a password command",
+				errorTypes.LwCliInvalidFlagValue)
+		}
+		return nil
+	}
+
+	authContext.PasswordCommand = ""
+	if authContext.Secret() == "" {
+		return fmt.Errorf("%w: a password or token is needed to save in the %s", errorTypes.LwCliInputError, store)
+	}
+
+	passwordStore, err := secrets.New(store, "")
+	if err != nil {
+		return err
+	}
+	if err := passwordStore.Set(authContext.ContextName, authContext.Secret()); err != nil {
+		return err
+	}
+	authContext.SetSecret("")
+
+	return nil
+}
+
+// forgetAuthContextSecret removes the password or token of authContext from its password
+// store, if it has one. Failing to is only warned about; the secret is merely left behind.
+func forgetAuthContextSecret(authContext cmdTypes.AuthContext) {
+	if authContext.PasswordStore != secrets.Keyring && authContext.PasswordStore != secrets.Vault {
+		return
+	}
+
+	passwordStore, err := secrets.New(authContext.PasswordStore, "")
+	if err == nil {
+		err = passwordStore.Delete(authContext.ContextName)
+	}
+	if err != nil {
+		utils.PrintYellow("WARNING: failed removing the password or token of context [%s] from the %s: %s\n",
+			authContext.ContextName, authContext.PasswordStore, err)
+	}
+}
+
+// ensureConfigFile creates an empty config file, readable only by the user, when there
+// is none yet.
+func ensureConfigFile() error {
+	file, err := getExpectedConfigPath()
+	if err != nil {
+		return err
+	}
+	if utils.FileExists(file) {
+		return nil
+	}
+
+	f, err := os.OpenFile(filepath.Clean(file), os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0600)
+	if err != nil {
+		if os.IsExist(err) {
+			return nil
+		}
+		return err
+	}
+
+	return f.Close()
+}
+
+// validateAuthContextTransport checks the proxy/TLS settings of an auth context can be
+// applied, so a broken context is never saved.
+func validateAuthContextTransport(authContext cmdTypes.AuthContext) error {
+	_, err := lwCliInstApi.NewHttpClient(lwCliInstApi.TransportSettings{
+		Insecure:      authContext.Insecure,
+		Proxy:         authContext.Proxy,
+		CaFile:        authContext.CaFile,
+		ClientCert:    authContext.ClientCert,
+		ClientKey:     authContext.ClientKey,
+		TlsServerName: authContext.TlsServerName,
+	})
+	if err != nil {
+		return fmt.Errorf("%w Raw error: %s", errorTypes.InvalidTransportSettings, err)
+	}
+
+	return nil
+}
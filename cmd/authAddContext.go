@@ -17,13 +17,15 @@ package cmd
 
 import (
 	"fmt"
-	"os"
-	"path/filepath"
 	"strings"
 
 	"github.com/spf13/cobra"
 
-	"github.com/liquidweb/liquidweb-cli/utils"
+	"github.com/liquidweb/liquidweb-cli/config"
+	"github.com/liquidweb/liquidweb-cli/instance"
+	"github.com/liquidweb/liquidweb-cli/secrets"
+	"github.com/liquidweb/liquidweb-cli/types/cmd"
+	"github.com/liquidweb/liquidweb-cli/types/errors"
 )
 
 var authAddContextCmd = &cobra.Command{
@@ -31,7 +33,15 @@ var authAddContextCmd = &cobra.Command{
 	Short: "Add a context to an existing configuration",
 	Long: `Add a context to an existing configuration.
 
-Use this if you've already setup contexts with "auth init".`,
+Use this if you've already setup contexts with "auth init".
+
+Contexts authenticate with a username and password, or with an API token given by
+--token (see --auth-type). The token's expiry, when given by --token-expires, is shown
+by "auth get-contexts" and "auth ping".
+
+The password or token is saved in the Secret Service keyring when one is available,
+else in a passphrase encrypted vault file (~/.liquidweb-cli-vault). See --password-store
+and --password-command for other options.`,
 	Run: func(cmd *cobra.Command, args []string) {
 		username, _ := cmd.Flags().GetString("username")
 		password, _ := cmd.Flags().GetString("password")
@@ -39,24 +49,61 @@ Use this if you've already setup contexts with "auth init".`,
 		url, _ := cmd.Flags().GetString("api-url")
 		insecure, _ := cmd.Flags().GetBool("insecure")
 		timeout, _ := cmd.Flags().GetInt("timeout")
-
-		contextName = strings.ToLower(contextName)
-
-		file, err := getExpectedConfigPath()
+		proxy, _ := cmd.Flags().GetString("proxy")
+		caFile, _ := cmd.Flags().GetString("ca-file")
+		clientCert, _ := cmd.Flags().GetString("client-cert")
+		clientKey, _ := cmd.Flags().GetString("client-key")
+		tlsServerName, _ := cmd.Flags().GetString("tls-server-name")
+		passwordStore, _ := cmd.Flags().GetString("password-store")
+		passwordCommand, _ := cmd.Flags().GetString("password-command")
+		authType, _ := cmd.Flags().GetString("auth-type")
+		token, _ := cmd.Flags().GetString("token")
+		tokenExpiresFlag, _ := cmd.Flags().GetString("token-expires")
+
+		if passwordCommand != "" && !cmd.Flags().Changed("password-store") {
+			passwordStore = secrets.Command
+		}
+		if token != "" && !cmd.Flags().Changed("auth-type") {
+			authType = cmdTypes.AuthTypeToken
+		}
+		if err := validateAuthType(authType); err != nil {
+			lwCliInst.Die(err)
+		}
+		tokenExpires, err := parseTokenExpires(tokenExpiresFlag)
 		if err != nil {
 			lwCliInst.Die(err)
 		}
-		if !utils.FileExists(file) {
-			f, err := os.Create(filepath.Clean(file))
-			if err != nil {
-				lwCliInst.Die(err)
+
+		if authType == cmdTypes.AuthTypeToken {
+			if password != "" {
+				lwCliInst.Die(fmt.Errorf("%w: --password can't be given for a token context",
+					errorTypes.LwCliInvalidFlagValue))
 			}
-			if err := f.Close(); err != nil {
-				lwCliInst.Die(err)
+			if token == "" && passwordStore != secrets.Command {
+				lwCliInst.Die(fmt.Errorf("%w: --token is required unless --password-command is given",
+					errorTypes.LwCliInputError))
 			}
-			if err := os.Chmod(file, 0600); err != nil {
-				lwCliInst.Die(err)
+		} else {
+			if token != "" || tokenExpires != "" {
+				lwCliInst.Die(fmt.Errorf("%w: --token and --token-expires need --auth-type %s",
+					errorTypes.LwCliInvalidFlagValue, cmdTypes.AuthTypeToken))
 			}
+			if username == "" {
+				lwCliInst.Die(fmt.Errorf("%w: --username is required", errorTypes.LwCliInputError))
+			}
+			if password == "" && passwordStore != secrets.Command {
+				lwCliInst.Die(fmt.Errorf("%w: --password is required unless --password-command is given",
+					errorTypes.LwCliInputError))
+			}
+		}
+
+		contextName = strings.ToLower(contextName)
+		if err := instance.ValidateContextName(contextName); err != nil {
+			lwCliInst.Die(err)
+		}
+
+		if err := ensureConfigFile(); err != nil {
+			lwCliInst.Die(err)
 		}
 
 		contexts := lwCliInst.Viper.GetStringMap("liquidweb.api.contexts")
@@ -64,20 +111,39 @@ Use this if you've already setup contexts with "auth init".`,
 			lwCliInst.Die(fmt.Errorf("context with name [%s] already exists", contextName))
 		}
 
-		lwCliInst.Viper.Set(fmt.Sprintf("liquidweb.api.contexts.%s", contextName), map[string]interface{}{
-			"contextname": contextName,
-			"username":    username,
-			"password":    password,
-			"url":         url,
-			"insecure":    insecure,
-			"timeout":     timeout,
-		})
+		authContext := cmdTypes.AuthContext{
+			ContextName:     contextName,
+			Username:        username,
+			Password:        password,
+			Token:           token,
+			TokenExpires:    tokenExpires,
+			Url:             url,
+			Insecure:        insecure,
+			Timeout:         timeout,
+			Proxy:           proxy,
+			CaFile:          caFile,
+			ClientCert:      clientCert,
+			ClientKey:       clientKey,
+			TlsServerName:   tlsServerName,
+			PasswordStore:   passwordStore,
+			PasswordCommand: passwordCommand,
+		}
+		if authType == cmdTypes.AuthTypeToken {
+			authContext.AuthType = authType
+		}
+		if err := validateAuthContextTransport(authContext); err != nil {
+			lwCliInst.Die(err)
+		}
+		if err := storeAuthContextSecret(&authContext); err != nil {
+			lwCliInst.Die(err)
+		}
 
-		if err := lwCliInst.Viper.WriteConfig(); err != nil {
+		if err := config.SaveAuthContext(lwCliInst.Viper, authContext); err != nil {
 			lwCliInst.Die(err)
 		}
 
-		fmt.Printf("Created context [%s]\n", contextName)
+		fmt.Printf("Created context [%s] (auth type: %s, password store: %s)\n", contextName, authType,
+			authContext.PasswordStore)
 	},
 }
 
@@ -85,16 +151,17 @@ func init() {
 	authCmd.AddCommand(authAddContextCmd)
 
 	authAddContextCmd.Flags().String("context", "", "name for new context")
-	authAddContextCmd.Flags().String("username", "", "username to authenticate with")
+	authAddContextCmd.Flags().String("username", "", "username to authenticate with (optional for token contexts)")
 	authAddContextCmd.Flags().String("password", "", "password for given username")
 	authAddContextCmd.Flags().Bool("insecure", false, "whether or not to perform SSL validation on api url")
 	authAddContextCmd.Flags().String("api-url", "https://api.liquidweb.com", "API URL to use")
 	authAddContextCmd.Flags().Int("timeout", 30, "timeout value when communicating with api-url")
-
-	if err := authAddContextCmd.MarkFlagRequired("username"); err != nil {
-		lwCliInst.Die(err)
-	}
-	if err := authAddContextCmd.MarkFlagRequired("password"); err != nil {
-		lwCliInst.Die(err)
-	}
+	authAddContextCmd.Flags().String("proxy", "",
+		"HTTP(S) proxy URL to reach api-url through (default honors HTTPS_PROXY/NO_PROXY)")
+	authAddContextCmd.Flags().String("ca-file", "", "PEM file of CAs to trust in addition to the system ones")
+	authAddContextCmd.Flags().String("client-cert", "", "PEM client certificate for TLS client authentication")
+	authAddContextCmd.Flags().String("client-key", "", "PEM private key for --client-cert")
+	authAddContextCmd.Flags().String("tls-server-name", "", "server name to verify the api-url certificate against")
+	addPasswordStoreFlags(authAddContextCmd, secrets.Auto)
+	addTokenFlags(authAddContextCmd, cmdTypes.AuthTypePassword)
 }
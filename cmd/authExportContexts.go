@@ -0,0 +1,162 @@
+/*
+Copyright © LiquidWeb
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/spf13/cobra"
+
+	"github.com/liquidweb/liquidweb-cli/instance"
+	"github.com/liquidweb/liquidweb-cli/secrets"
+	"github.com/liquidweb/liquidweb-cli/types/cmd"
+)
+
+// authContextsExportVersion is the version of the file format written by
+// "auth export-contexts".
+const authContextsExportVersion = 1
+
+// authContextsExport is the file "auth export-contexts" writes and "auth import-contexts"
+// reads. Contexts carry their password or token in plaintext, unless stripped; when
+// the export is encrypted they are in Sealed instead, as json.
+type authContextsExport struct {
+	Version  int                    `json:"version"`
+	Contexts []cmdTypes.AuthContext `json:"contexts,omitempty"`
+	Sealed   *secrets.Sealed        `json:"sealed,omitempty"`
+}
+
+var authExportContextsCmd = &cobra.Command{
+	Use:   "export-contexts",
+	Short: "Export auth contexts to a file",
+	Long: `Export auth contexts to a file, for "auth import-contexts" on another machine.
+
+Every context is exported unless --contexts names some. Passwords and tokens are looked
+up in their password store and written along with the contexts, unless --strip-secrets
+is given; contexts using a password command keep the command instead. Pass --encrypt to
+encrypt the file with a passphrase, read from LW_EXPORT_PASSPHRASE or prompted for.
+
+Examples:
+
+  auth export-contexts --encrypt --file contexts.lw
+  auth export-contexts --contexts dev,staging --strip-secrets > contexts.json`,
+	Run: func(cmd *cobra.Command, args []string) {
+		contextsFlag, _ := cmd.Flags().GetStringSlice("contexts")
+		encrypt, _ := cmd.Flags().GetBool("encrypt")
+		stripSecrets, _ := cmd.Flags().GetBool("strip-secrets")
+		outputFile, _ := cmd.Flags().GetString("file")
+
+		contexts := lwCliInst.Viper.GetStringMap("liquidweb.api.contexts")
+		for _, name := range contextsFlag {
+			if err := instance.ValidateContext(name, lwCliInst.Viper); err != nil {
+				lwCliInst.Die(err)
+			}
+		}
+		names := contextsFlag
+		if len(names) == 0 {
+			for name := range contexts {
+				names = append(names, name)
+			}
+		}
+		sort.Strings(names)
+		if len(names) == 0 {
+			lwCliInst.Die(fmt.Errorf("there are no auth contexts to export; see 'auth init'"))
+		}
+
+		currentContext := lwCliInst.Viper.GetString("liquidweb.api.current_context")
+		export := authContextsExport{Version: authContextsExportVersion}
+		for _, name := range names {
+			var authContext cmdTypes.AuthContext
+			if err := instance.CastFieldTypes(contexts[name], &authContext); err != nil {
+				lwCliInst.Die(err)
+			}
+			authContext.CurrentContext = name == currentContext
+
+			// a password command is carried over as is; it is the user's to make work elsewhere
+			if authContext.PasswordStore != secrets.Command {
+				if !stripSecrets {
+					secret, err := secrets.Password(name, authContext.PasswordStore, authContext.PasswordCommand,
+						authContext.Secret())
+					if err != nil {
+						lwCliInst.Die(fmt.Errorf("error looking up the secret of context [%s]: %s", name, err))
+					}
+					authContext.SetSecret(secret)
+				} else {
+					authContext.SetSecret("")
+				}
+				authContext.PasswordStore = ""
+				authContext.PasswordCommand = ""
+			}
+
+			export.Contexts = append(export.Contexts, authContext)
+		}
+
+		if encrypt {
+			plaintext, err := json.Marshal(export.Contexts)
+			if err != nil {
+				lwCliInst.Die(err)
+			}
+			passphrase, err := secrets.ReadPassphrase(secrets.ExportPassphraseEnv, "Passphrase for the export: ", true)
+			if err != nil {
+				lwCliInst.Die(err)
+			}
+			sealed, err := secrets.Seal(passphrase, plaintext)
+			if err != nil {
+				lwCliInst.Die(err)
+			}
+			export.Contexts = nil
+			export.Sealed = &sealed
+		} else if !stripSecrets {
+			fmt.Fprintln(os.Stderr, "WARNING: the export holds passwords and tokens in plaintext; "+
+				"consider --encrypt or --strip-secrets")
+		}
+
+		data, err := json.MarshalIndent(export, "", "  ")
+		if err != nil {
+			lwCliInst.Die(err)
+		}
+		data = append(data, '\n')
+
+		if outputFile == "" || outputFile == "-" {
+			if _, err := os.Stdout.Write(data); err != nil {
+				lwCliInst.Die(err)
+			}
+			return
+		}
+		if err := ioutil.WriteFile(filepath.Clean(outputFile), data, 0600); err != nil {
+			lwCliInst.Die(err)
+		}
+		// WriteFile leaves the mode of an existing file alone
+		if err := os.Chmod(outputFile, 0600); err != nil {
+			lwCliInst.Die(err)
+		}
+
+		fmt.Printf("Exported %d contexts to [%s]\n", len(names), outputFile)
+	},
+}
+
+func init() {
+	authCmd.AddCommand(authExportContextsCmd)
+
+	authExportContextsCmd.Flags().StringSlice("contexts", []string{}, "contexts to export (default all)")
+	authExportContextsCmd.Flags().Bool("encrypt", false, "encrypt the export with a passphrase")
+	authExportContextsCmd.Flags().Bool("strip-secrets", false, "leave passwords and tokens out of the export")
+	authExportContextsCmd.Flags().String("file", "", "file to write the export to (default stdout)")
+}
@@ -28,6 +28,10 @@ var authGetContextCmd = &cobra.Command{
 
 If you've never setup any contexts, check "auth init".`,
 	Run: func(cmd *cobra.Command, args []string) {
+		if envContext := lwCliInst.LwCliApiClient.EnvContext; envContext != nil {
+			fmt.Printf("Auth context: [%s] (ephemeral, from LW_* environment variables)\n", envContext.ContextName)
+			return
+		}
 		fmt.Printf("Auth context: [%s]\n", lwCliInst.Viper.GetString("liquidweb.api.current_context"))
 	},
 }
@@ -21,6 +21,7 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/liquidweb/liquidweb-cli/instance"
+	"github.com/liquidweb/liquidweb-cli/secrets"
 	"github.com/liquidweb/liquidweb-cli/types/cmd"
 )
 
@@ -41,12 +42,43 @@ If you've never setup any contexts, check "auth init".`,
 
 			fmt.Printf("Context: %s\n", context.ContextName)
 			fmt.Printf("\tUsername: %s\n", context.Username)
+			printAuthContextAuthType(context)
 			fmt.Printf("\tAPI URL: %s\n", context.Url)
 			fmt.Printf("\tInsecure: %t\n", context.Insecure)
 			fmt.Printf("\tTimeout: %d\n", context.Timeout)
+			switch context.PasswordStore {
+			case "":
+				fmt.Printf("\tPassword Store: plaintext (see 'auth migrate-passwords')\n")
+			case secrets.Command:
+				fmt.Printf("\tPassword Store: %s\n", context.PasswordStore)
+				fmt.Printf("\tPassword Command: %s\n", context.PasswordCommand)
+			default:
+				fmt.Printf("\tPassword Store: %s\n", context.PasswordStore)
+			}
+			if context.Proxy != "" {
+				fmt.Printf("\tProxy: %s\n", context.Proxy)
+			}
+			if context.CaFile != "" {
+				fmt.Printf("\tCA File: %s\n", context.CaFile)
+			}
+			if context.ClientCert != "" {
+				fmt.Printf("\tClient Certificate: %s\n", context.ClientCert)
+				fmt.Printf("\tClient Key: %s\n", context.ClientKey)
+			}
+			if context.TlsServerName != "" {
+				fmt.Printf("\tTLS Server Name: %s\n", context.TlsServerName)
+			}
 		}
 
 		currentContext := lwCliInst.Viper.GetString("liquidweb.api.current_context")
+		if envContext := lwCliInst.LwCliApiClient.EnvContext; envContext != nil {
+			fmt.Printf("Context: %s (ephemeral, from LW_* environment variables)\n", envContext.ContextName)
+			fmt.Printf("\tUsername: %s\n", envContext.Username)
+			printAuthContextAuthType(*envContext)
+			fmt.Printf("\tAPI URL: %s\n", envContext.Url)
+			fmt.Printf("\tTimeout: %d\n", envContext.Timeout)
+			currentContext = envContext.ContextName
+		}
 		fmt.Printf("Current context: [%s]\n", currentContext)
 	},
 }
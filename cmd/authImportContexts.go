@@ -0,0 +1,291 @@
+/*
+Copyright © LiquidWeb
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/liquidweb/liquidweb-cli/config"
+	"github.com/liquidweb/liquidweb-cli/instance"
+	"github.com/liquidweb/liquidweb-cli/secrets"
+	"github.com/liquidweb/liquidweb-cli/types/cmd"
+	"github.com/liquidweb/liquidweb-cli/types/errors"
+	"github.com/liquidweb/liquidweb-cli/validate"
+)
+
+// What "auth import-contexts" does with a context whose name is already configured.
+const (
+	importConflictError     = "error"
+	importConflictSkip      = "skip"
+	importConflictOverwrite = "overwrite"
+	importConflictRename    = "rename"
+)
+
+var authImportContextsCmd = &cobra.Command{
+	Use:   "import-contexts FILE",
+	Short: "Import auth contexts from a file",
+	Long: `Import auth contexts from a file written by "auth export-contexts", merging them
+into the existing configuration. Pass - as FILE to read it from stdin.
+
+Every context in the file is imported unless --contexts names some. The passphrase of an
+encrypted export is read from LW_EXPORT_PASSPHRASE or prompted for. Imported passwords
+and tokens are saved in the password store given by --password-store; contexts exported
+with --strip-secrets need theirs set afterwards with "auth update-context".
+
+A context exported with a password command (see --password-command) would have that
+command run by the shell whenever it authenticates, so password commands are dropped
+from imported contexts, leaving them to have a password set with "auth update-context".
+Pass --allow-password-commands to keep them, once you have read them; each one kept is
+printed.
+
+--on-conflict decides what happens to a context whose name is already configured:
+
+  error      import nothing (default)
+  skip       keep the configured context
+  overwrite  replace the configured context
+  rename     import it as NAME-imported
+
+The current context is only changed when none is set yet.
+
+Example:
+
+  auth import-contexts contexts.lw --on-conflict rename`,
+	Run: func(cmd *cobra.Command, args []string) {
+		if len(args) != 1 {
+			if err := cmd.Help(); err != nil {
+				lwCliInst.Die(err)
+			}
+			os.Exit(1)
+		}
+
+		contextsFlag, _ := cmd.Flags().GetStringSlice("contexts")
+		onConflict, _ := cmd.Flags().GetString("on-conflict")
+		passwordStoreFlag, _ := cmd.Flags().GetString("password-store")
+		allowPasswordCommands, _ := cmd.Flags().GetBool("allow-password-commands")
+
+		switch onConflict {
+		case importConflictError, importConflictSkip, importConflictOverwrite, importConflictRename:
+		default:
+			lwCliInst.Die(fmt.Errorf("%w: --on-conflict must be one of %s, not [%s]", errorTypes.LwCliInvalidFlagValue,
+				strings.Join([]string{importConflictError, importConflictSkip, importConflictOverwrite,
+					importConflictRename}, ", "), onConflict))
+		}
+		store, err := secrets.Resolve(passwordStoreFlag)
+		if err != nil || store == secrets.Command {
+			lwCliInst.Die(fmt.Errorf("%w: --password-store must be one of auto, keyring, vault, plaintext",
+				errorTypes.LwCliInvalidFlagValue))
+		}
+
+		imported, err := readAuthContextsExport(args[0])
+		if err != nil {
+			lwCliInst.Die(err)
+		}
+
+		wanted := map[string]bool{}
+		for _, name := range contextsFlag {
+			wanted[strings.ToLower(name)] = true
+		}
+		var authContexts []cmdTypes.AuthContext
+		for _, authContext := range imported {
+			authContext.ContextName = strings.ToLower(authContext.ContextName)
+			if len(wanted) > 0 && !wanted[authContext.ContextName] {
+				continue
+			}
+			delete(wanted, authContext.ContextName)
+			if err := validateImportedAuthContext(authContext); err != nil {
+				lwCliInst.Die(err)
+			}
+			authContexts = append(authContexts, authContext)
+		}
+		for name := range wanted {
+			lwCliInst.Die(fmt.Errorf("context [%s] isn't in [%s]", name, args[0]))
+		}
+
+		// check for conflicts before changing anything, so an import is all or nothing
+		contexts := lwCliInst.Viper.GetStringMap("liquidweb.api.contexts")
+		if onConflict == importConflictError {
+			var conflicts []string
+			for _, authContext := range authContexts {
+				if instance.ValidateContext(authContext.ContextName, lwCliInst.Viper) == nil {
+					conflicts = append(conflicts, authContext.ContextName)
+				}
+			}
+			if len(conflicts) > 0 {
+				lwCliInst.Die(fmt.Errorf("%w: contexts [%s] already exist; see --on-conflict",
+					errorTypes.LwCliInputError, strings.Join(conflicts, ", ")))
+			}
+		}
+
+		if err := ensureConfigFile(); err != nil {
+			lwCliInst.Die(err)
+		}
+
+		for _, authContext := range authContexts {
+			name := authContext.ContextName
+			var previous *cmdTypes.AuthContext
+			if instance.ValidateContext(name, lwCliInst.Viper) == nil {
+				switch onConflict {
+				case importConflictSkip:
+					fmt.Printf("Skipped context [%s]: it already exists\n", name)
+					continue
+				case importConflictOverwrite:
+					previous = &cmdTypes.AuthContext{}
+					if err := instance.CastFieldTypes(contexts[name], previous); err != nil {
+						lwCliInst.Die(err)
+					}
+				case importConflictRename:
+					authContext.ContextName = fmt.Sprintf("%s-imported", name)
+					for n := 2; instance.ValidateContext(authContext.ContextName, lwCliInst.Viper) == nil; n++ {
+						authContext.ContextName = fmt.Sprintf("%s-imported-%d", name, n)
+					}
+				}
+			}
+
+			// contexts using a password command keep it when allowed; others move to the chosen store
+			hasSecret := authContext.Secret() != ""
+			keepsCommand := authContext.PasswordStore == secrets.Command && allowPasswordCommands
+			droppedCommand := ""
+			if authContext.PasswordStore == secrets.Command && !keepsCommand {
+				droppedCommand = authContext.PasswordCommand
+			}
+			if !keepsCommand {
+				authContext.PasswordStore = store
+				authContext.PasswordCommand = ""
+				if hasSecret {
+					if err := storeAuthContextSecret(&authContext); err != nil {
+						lwCliInst.Die(err)
+					}
+				}
+			}
+
+			makeCurrent := authContext.CurrentContext && lwCliInst.Viper.GetString("liquidweb.api.current_context") == ""
+			if err := config.SaveAuthContext(lwCliInst.Viper, authContext); err != nil {
+				lwCliInst.Die(err)
+			}
+			if makeCurrent {
+				if err := config.SaveCurrentContext(lwCliInst.Viper, authContext.ContextName); err != nil {
+					lwCliInst.Die(err)
+				}
+			}
+			if previous != nil && previous.PasswordStore != authContext.PasswordStore {
+				forgetAuthContextSecret(*previous)
+			}
+
+			if authContext.ContextName != name {
+				fmt.Printf("Imported context [%s] as [%s]\n", name, authContext.ContextName)
+			} else {
+				fmt.Printf("Imported context [%s]\n", name)
+			}
+			switch {
+			case keepsCommand:
+				fmt.Printf("\tkept its password command, which is run through the shell: %s\n",
+					authContext.PasswordCommand)
+			case droppedCommand != "":
+				fmt.Printf("\tdropped its password command [%s]; set a password with "+
+					"'auth update-context --context %s', or import again with --allow-password-commands\n",
+					droppedCommand, authContext.ContextName)
+			case !hasSecret:
+				fmt.Printf("\tno password or token was exported; set one with 'auth update-context --context %s'\n",
+					authContext.ContextName)
+			}
+		}
+	},
+}
+
+func init() {
+	authCmd.AddCommand(authImportContextsCmd)
+
+	authImportContextsCmd.Flags().StringSlice("contexts", []string{}, "contexts to import (default all)")
+	authImportContextsCmd.Flags().String("on-conflict", importConflictError,
+		"what to do with contexts that already exist; one of error, skip, overwrite, rename")
+	authImportContextsCmd.Flags().String("password-store", secrets.Auto,
+		"where to keep imported passwords and tokens; one of auto, keyring, vault, plaintext")
+	authImportContextsCmd.Flags().Bool("allow-password-commands", false,
+		"keep the password commands of imported contexts, which run through the shell (default drop them)")
+}
+
+// readAuthContextsExport returns the contexts in an export file, decrypting it when it
+// is encrypted. A file of - is read from stdin.
+func readAuthContextsExport(file string) ([]cmdTypes.AuthContext, error) {
+	var (
+		data []byte
+		err  error
+	)
+	if file == "-" {
+		data, err = ioutil.ReadAll(os.Stdin)
+	} else {
+		data, err = ioutil.ReadFile(filepath.Clean(file))
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var export authContextsExport
+	if err := json.Unmarshal(data, &export); err != nil {
+		return nil, fmt.Errorf("%w: [%s] isn't an export of auth contexts: %s", errorTypes.LwCliInputError, file, err)
+	}
+	if export.Version != authContextsExportVersion {
+		return nil, fmt.Errorf("%w: [%s] has unknown export version [%d]", errorTypes.LwCliInputError, file,
+			export.Version)
+	}
+
+	if export.Sealed != nil {
+		passphrase, err := secrets.ReadPassphrase(secrets.ExportPassphraseEnv, "Passphrase of the export: ", false)
+		if err != nil {
+			return nil, err
+		}
+		plaintext, err := export.Sealed.Open(passphrase)
+		if err != nil {
+			return nil, fmt.Errorf("%w [%s]", err, file)
+		}
+		if err := json.Unmarshal(plaintext, &export.Contexts); err != nil {
+			return nil, err
+		}
+	}
+
+	return export.Contexts, nil
+}
+
+// validateImportedAuthContext checks a context read from an export could have been
+// created with "auth add-context".
+func validateImportedAuthContext(authContext cmdTypes.AuthContext) error {
+	if err := instance.ValidateContextName(authContext.ContextName); err != nil {
+		return err
+	}
+	if authContext.Timeout != 0 {
+		if err := validate.Validate(map[interface{}]interface{}{authContext.Timeout: "PositiveInt"}); err != nil {
+			return err
+		}
+	}
+	if authContext.AuthType != "" {
+		if err := validateAuthType(authContext.AuthType); err != nil {
+			return fmt.Errorf("context [%s]: %w", authContext.ContextName, err)
+		}
+	}
+	if authContext.PasswordStore == secrets.Command && authContext.PasswordCommand == "" {
+		return fmt.Errorf("%w: context [%s] uses a password command, but has none", errorTypes.LwCliInputError,
+			authContext.ContextName)
+	}
+
+	return validateAuthContextTransport(authContext)
+}
@@ -16,10 +16,9 @@ limitations under the License.
 package cmd
 
 import (
-	"bytes"
 	"fmt"
+	"io/ioutil"
 	"os"
-	"path/filepath"
 	"strings"
 	"syscall"
 
@@ -28,7 +27,13 @@ import (
 	"github.com/c-bata/go-prompt"
 	"github.com/spf13/cobra"
 
+	lwClient "github.com/liquidweb/liquidweb-cli/client"
+	"github.com/liquidweb/liquidweb-cli/config"
+	"github.com/liquidweb/liquidweb-cli/instance"
+	lwCliInstApi "github.com/liquidweb/liquidweb-cli/instance/api"
+	"github.com/liquidweb/liquidweb-cli/secrets"
 	cmdTypes "github.com/liquidweb/liquidweb-cli/types/cmd"
+	"github.com/liquidweb/liquidweb-cli/types/errors"
 	"github.com/liquidweb/liquidweb-cli/utils"
 	"github.com/liquidweb/liquidweb-cli/validate"
 )
@@ -38,9 +43,44 @@ var authInitCmd = &cobra.Command{
 	Short: "Specify your LiquidWeb API credentials from a blank slate",
 	Long: `Specify your LiquidWeb API credentials from a blank slate.
 
-Intended to be ran for initial setup only.`,
+Intended to be ran for initial setup only.
+
+With --non-interactive nothing is prompted for, so it can be scripted: the context is
+given by --context, --username, --api-url and --timeout, with the password (or API
+token) read from stdin by --password-stdin (or --token-stdin). Alternatively
+--contexts-file takes the contexts from a file written by "auth export-contexts".
+The credentials are verified with a ping before the config is written, and existing
+contexts are only replaced when --force is given.
+
+Example:
+
+  echo "$LW_API_PASSWORD" | lw auth init --non-interactive --context ci --username ci-bot --password-stdin
+
+Each context authenticates with a username and password, or with an API token.
+Passwords and tokens are saved in the Secret Service keyring when one is available, else in a
+passphrase encrypted vault file (~/.liquidweb-cli-vault). See --password-store.`,
 	Run: func(cmd *cobra.Command, args []string) {
-		if err := setAuthDataInteractively(); err != nil {
+		passwordStore, _ := cmd.Flags().GetString("password-store")
+		nonInteractive, _ := cmd.Flags().GetBool("non-interactive")
+
+		store, err := secrets.Resolve(passwordStore)
+		if err != nil || store == secrets.Command {
+			lwCliInst.Die(fmt.Errorf("%w: --password-store must be one of auto, keyring, vault, plaintext; "+
+				"see 'auth update-context --password-command' for password commands", errorTypes.LwCliInvalidFlagValue))
+		}
+
+		if nonInteractive {
+			if err := initNonInteractively(cmd, store); err != nil {
+				lwCliInst.Die(err)
+			}
+			return
+		}
+		if !terminal.IsTerminal(int(os.Stdin.Fd())) {
+			lwCliInst.Die(fmt.Errorf("%w: auth init prompts for its answers, but stdin isn't a terminal; "+
+				"see --non-interactive", errorTypes.LwCliInputError))
+		}
+
+		if err := setAuthDataInteractively(store); err != nil {
 			lwCliInst.Die(err)
 		}
 	},
@@ -48,24 +88,163 @@ Intended to be ran for initial setup only.`,
 
 func init() {
 	authCmd.AddCommand(authInitCmd)
+
+	authInitCmd.Flags().String("password-store", secrets.Auto,
+		"where to keep the passwords; one of auto, keyring, vault, plaintext. auto is the keyring when available, else the vault")
+	authInitCmd.Flags().Bool("non-interactive", false, "take everything from flags and stdin instead of prompting")
+	authInitCmd.Flags().Bool("force", false, "with --non-interactive, replace existing auth contexts")
+	authInitCmd.Flags().String("context", "", "with --non-interactive, name for the context")
+	authInitCmd.Flags().String("username", "", "with --non-interactive, username to authenticate with")
+	authInitCmd.Flags().Bool("password-stdin", false, "with --non-interactive, read the password from stdin")
+	authInitCmd.Flags().Bool("token-stdin", false, "with --non-interactive, read an API token from stdin")
+	authInitCmd.Flags().String("api-url", "https://api.liquidweb.com", "with --non-interactive, API URL to use")
+	authInitCmd.Flags().Int("timeout", 90, "with --non-interactive, timeout value when communicating with api-url")
+	authInitCmd.Flags().String("contexts-file", "",
+		"with --non-interactive, file from 'auth export-contexts' to take the contexts from")
 }
 
-func setAuthDataInteractively() error {
-	writeConfig, err := fetchAuthDataInteractively()
-	if err != nil {
-		return err
+// initNonInteractively replaces the auth contexts with the one given by flags, or those
+// in --contexts-file, once their credentials are verified.
+func initNonInteractively(cmd *cobra.Command, passwordStore string) error {
+	contextsFile, _ := cmd.Flags().GetString("contexts-file")
+	force, _ := cmd.Flags().GetBool("force")
+
+	var contexts []cmdTypes.AuthContext
+	if contextsFile != "" {
+		for _, flag := range []string{"context", "username", "password-stdin", "token-stdin", "api-url", "timeout"} {
+			if cmd.Flags().Changed(flag) {
+				return fmt.Errorf("%w: --%s can't be given along with --contexts-file", errorTypes.LwCliInvalidFlagValue,
+					flag)
+			}
+		}
+
+		imported, err := readAuthContextsExport(contextsFile)
+		if err != nil {
+			return err
+		}
+		var haveCurrent bool
+		for _, authContext := range imported {
+			authContext.ContextName = strings.ToLower(authContext.ContextName)
+			if err := validateImportedAuthContext(authContext); err != nil {
+				return err
+			}
+			if authContext.Secret() == "" && authContext.PasswordStore != secrets.Command {
+				return fmt.Errorf("%w: context [%s] in [%s] has no password or token; was it exported with --strip-secrets?",
+					errorTypes.LwCliInputError, authContext.ContextName, contextsFile)
+			}
+			haveCurrent = haveCurrent || authContext.CurrentContext
+			contexts = append(contexts, authContext)
+		}
+		if len(contexts) == 0 {
+			return fmt.Errorf("%w: [%s] has no auth contexts", errorTypes.LwCliInputError, contextsFile)
+		}
+		if !haveCurrent {
+			contexts[0].CurrentContext = true
+		}
+	} else {
+		authContext, err := authContextFromInitFlags(cmd)
+		if err != nil {
+			return err
+		}
+		contexts = append(contexts, authContext)
+	}
+
+	if !force && len(lwCliInst.Viper.GetStringMap("liquidweb.api.contexts")) > 0 {
+		return fmt.Errorf("%w: auth init replaces the existing auth contexts; pass --force to proceed, "+
+			"or see 'auth add-context'", errorTypes.LwCliInputError)
 	}
 
-	if writeConfig {
-		if err := lwCliInst.Viper.WriteConfig(); err != nil {
+	for _, authContext := range contexts {
+		if err := verifyAuthContext(authContext); err != nil {
 			return err
 		}
 	}
 
+	if err := replaceAuthContexts(contexts, passwordStore); err != nil {
+		return err
+	}
+
+	for _, authContext := range contexts {
+		fmt.Printf("Created context [%s]\n", authContext.ContextName)
+	}
+
 	return nil
 }
 
-func fetchAuthDataInteractively() (writeConfig bool, err error) {
+// authContextFromInitFlags returns the auth context given by the flags of a
+// non-interactive "auth init", reading its password or token from stdin.
+func authContextFromInitFlags(cmd *cobra.Command) (authContext cmdTypes.AuthContext, err error) {
+	contextName, _ := cmd.Flags().GetString("context")
+	username, _ := cmd.Flags().GetString("username")
+	passwordStdin, _ := cmd.Flags().GetBool("password-stdin")
+	tokenStdin, _ := cmd.Flags().GetBool("token-stdin")
+	apiUrl, _ := cmd.Flags().GetString("api-url")
+	timeout, _ := cmd.Flags().GetInt("timeout")
+
+	if contextName == "" {
+		err = fmt.Errorf("%w: --context is required with --non-interactive", errorTypes.LwCliInputError)
+		return
+	}
+	if passwordStdin == tokenStdin {
+		err = fmt.Errorf("%w: one of --password-stdin and --token-stdin is required with --non-interactive",
+			errorTypes.LwCliInputError)
+		return
+	}
+	if passwordStdin && username == "" {
+		err = fmt.Errorf("%w: --username is required with --password-stdin", errorTypes.LwCliInputError)
+		return
+	}
+
+	contextName = strings.ToLower(contextName)
+	if err = instance.ValidateContextName(contextName); err != nil {
+		return
+	}
+
+	authContext = cmdTypes.AuthContext{
+		CurrentContext: true,
+		ContextName:    contextName,
+		Username:       username,
+		Url:            apiUrl,
+		Timeout:        timeout,
+	}
+	if tokenStdin {
+		authContext.AuthType = cmdTypes.AuthTypeToken
+	}
+
+	secret, err := ioutil.ReadAll(os.Stdin)
+	if err != nil {
+		return
+	}
+	authContext.SetSecret(strings.TrimRight(string(secret), "\r\n"))
+	if authContext.Secret() == "" {
+		err = fmt.Errorf("%w: nothing was read from stdin", errorTypes.LwCliInputError)
+		return
+	}
+
+	err = validate.Validate(map[interface{}]interface{}{authContext.Timeout: "PositiveInt"})
+
+	return
+}
+
+// verifyAuthContext pings the api as authContext, so wrong credentials are caught before
+// they are saved.
+func verifyAuthContext(authContext cmdTypes.AuthContext) error {
+	if err := validateAuthContextTransport(authContext); err != nil {
+		return err
+	}
+
+	apiClient, err := lwCliInstApi.NewForAuthContext(authContext)
+	if err != nil {
+		return err
+	}
+	if _, err := lwClient.New(apiClient).Utilities.Info.Ping(lwCliInst.Context()); err != nil {
+		return fmt.Errorf("verifying the credentials of context [%s] failed: %w", authContext.ContextName, err)
+	}
+
+	return nil
+}
+
+func setAuthDataInteractively(passwordStore string) (err error) {
 	var (
 		moreAdds          bool
 		haveProceedAnswer bool
@@ -108,6 +287,7 @@ func fetchAuthDataInteractively() (writeConfig bool, err error) {
 			var (
 				context                      cmdTypes.AuthContext
 				haveContextNameAnswer        bool
+				haveAuthTypeAnswer           bool
 				haveUsernameAnswer           bool
 				havePasswordAnswer           bool
 				haveMakeCurrentContextAnswer bool
@@ -122,11 +302,39 @@ func fetchAuthDataInteractively() (writeConfig bool, err error) {
 					userInputExitEarly <- true
 					break WHILEMOREADDS
 				} else if answer != "" {
+					if err := instance.ValidateContextName(answer); err != nil {
+						utils.PrintRed("%s\n", err)
+						continue
+					}
 					haveContextNameAnswer = true
 					context.ContextName = answer
 				}
 			}
 
+			// auth type
+			for !haveAuthTypeAnswer {
+				f := func(d prompt.Document) []prompt.Suggest {
+					s := []prompt.Suggest{
+						{Text: cmdTypes.AuthTypePassword, Description: "Authenticate with a username and password"},
+						{Text: cmdTypes.AuthTypeToken, Description: "Authenticate with an API token"},
+					}
+					return prompt.FilterHasPrefix(s, d.GetWordBeforeCursor(), true)
+				}
+				fmt.Print("Authenticate with? ")
+				answer := strings.ToLower(prompt.Input("> ", f, prompt.OptionShowCompletionAtStart()))
+				if answer == cmdTypes.AuthTypePassword {
+					haveAuthTypeAnswer = true
+				} else if answer == cmdTypes.AuthTypeToken {
+					haveAuthTypeAnswer = true
+					context.AuthType = cmdTypes.AuthTypeToken
+					// a token needs no username
+					haveUsernameAnswer = true
+				} else if answer == "exit" {
+					userInputExitEarly <- true
+					break WHILEMOREADDS
+				}
+			}
+
 			// username
 			for !haveUsernameAnswer {
 				fmt.Print("LiquidWeb username: ")
@@ -140,9 +348,13 @@ func fetchAuthDataInteractively() (writeConfig bool, err error) {
 				}
 			}
 
-			// password
+			// password or token
 			for !havePasswordAnswer {
-				fmt.Print("LiquidWeb password: ")
+				if context.UsesToken() {
+					fmt.Print("LiquidWeb API token: ")
+				} else {
+					fmt.Print("LiquidWeb password: ")
+				}
 				passwordBytes, err := terminal.ReadPassword(int(syscall.Stdin))
 				if err != nil {
 					userInputError <- err
@@ -154,7 +366,7 @@ func fetchAuthDataInteractively() (writeConfig bool, err error) {
 					break WHILEMOREADDS
 				} else if answer != "" {
 					havePasswordAnswer = true
-					context.Password = answer
+					context.SetSecret(answer)
 				}
 				fmt.Println("")
 			}
@@ -213,8 +425,10 @@ func fetchAuthDataInteractively() (writeConfig bool, err error) {
 				context.Url:         "HttpsLiquidwebUrl",
 				context.Timeout:     "PositiveInt",
 				context.ContextName: "NonEmptyString",
-				context.Username:    "NonEmptyString",
-				context.Password:    "NonEmptyString",
+				context.Secret():    "NonEmptyString",
+			}
+			if !context.UsesToken() {
+				validateFields[context.Username] = "NonEmptyString"
 			}
 			if err := validate.Validate(validateFields); err != nil {
 				userInputError <- err
@@ -244,63 +458,7 @@ WAIT:
 			contexts = append(contexts, context)
 		case complete := <-userInputComplete:
 			if complete {
-				// wipe the config for a clean slate.
-				if err := writeEmptyConfig(); err != nil {
-					lwCliInst.Die(err)
-				}
-				cfgFile, cfgPathErr := getExpectedConfigPath()
-				if cfgPathErr != nil {
-					err = cfgPathErr
-					return
-				}
-				if utils.FileExists(cfgFile) {
-					if err := os.Remove(cfgFile); err != nil {
-						lwCliInst.Die(err)
-					}
-					f, err := os.Create(filepath.Clean(cfgFile))
-					if err != nil {
-						lwCliInst.Die(err)
-					}
-					if err := f.Close(); err != nil {
-						lwCliInst.Die(err)
-					}
-					if err := os.Chmod(cfgFile, 0600); err != nil {
-						lwCliInst.Die(err)
-					}
-
-					if err := lwCliInst.Viper.ReadConfig(bytes.NewBuffer([]byte{})); err != nil {
-						lwCliInst.Die(err)
-					}
-				}
-
-				// set Viper config from contexts slice
-				for _, context := range contexts {
-					// ContextName
-					lwCliInst.Viper.Set(fmt.Sprintf(
-						"liquidweb.api.contexts.%s.contextname", context.ContextName), context.ContextName)
-					// Username
-					lwCliInst.Viper.Set(fmt.Sprintf("liquidweb.api.contexts.%s.username",
-						context.ContextName), context.Username)
-					// Password
-					lwCliInst.Viper.Set(fmt.Sprintf("liquidweb.api.contexts.%s.password",
-						context.ContextName), context.Password)
-					// CurrentContext
-					if context.CurrentContext {
-						lwCliInst.Viper.Set("liquidweb.api.current_context", context.ContextName)
-					}
-					// Url
-					lwCliInst.Viper.Set(fmt.Sprintf("liquidweb.api.contexts.%s.url",
-						context.ContextName), context.Url)
-					// Timeout
-					lwCliInst.Viper.Set(fmt.Sprintf("liquidweb.api.contexts.%s.timeout",
-						context.ContextName), context.Timeout)
-					// Insecure
-					lwCliInst.Viper.Set(fmt.Sprintf("liquidweb.api.contexts.%s.insecure",
-						context.ContextName), context.Insecure)
-				}
-
-				// no errors or early exits, so signify to write the config just set then break
-				writeConfig = true
+				err = replaceAuthContexts(contexts, passwordStore)
 				break WAIT
 			}
 		}
@@ -309,34 +467,21 @@ WAIT:
 	return
 }
 
-func getExpectedConfigPath() (string, error) {
-	homedir, err := os.UserHomeDir()
-	if err != nil {
-		return "", err
+// replaceAuthContexts replaces the auth contexts of the config with contexts, saving
+// their passwords and tokens in passwordStore.
+func replaceAuthContexts(contexts []cmdTypes.AuthContext, passwordStore string) error {
+	for i := range contexts {
+		if contexts[i].PasswordStore != secrets.Command {
+			contexts[i].PasswordStore = passwordStore
+		}
+		if err := storeAuthContextSecret(&contexts[i]); err != nil {
+			return err
+		}
 	}
 
-	cfgFile := fmt.Sprintf("%s/.liquidweb-cli.yaml", homedir)
-
-	return cfgFile, nil
+	return config.ReplaceAuthContexts(lwCliInst.Viper, contexts)
 }
 
-func writeEmptyConfig() error {
-	cfgFile, err := getExpectedConfigPath()
-	if err != nil {
-		return err
-	}
-
-	f, err := os.Create(filepath.Clean(cfgFile))
-	if err != nil {
-		return err
-	}
-	if err := f.Close(); err != nil {
-		return err
-	}
-
-	if err := os.Chmod(cfgFile, 0600); err != nil {
-		return err
-	}
-
-	return nil
+func getExpectedConfigPath() (string, error) {
+	return config.File(lwCliInst.Viper)
 }
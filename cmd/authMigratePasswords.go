@@ -0,0 +1,115 @@
+/*
+Copyright © LiquidWeb
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package cmd
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/spf13/cobra"
+
+	"github.com/liquidweb/liquidweb-cli/config"
+	"github.com/liquidweb/liquidweb-cli/instance"
+	"github.com/liquidweb/liquidweb-cli/secrets"
+	"github.com/liquidweb/liquidweb-cli/types/cmd"
+	"github.com/liquidweb/liquidweb-cli/types/errors"
+)
+
+var authMigratePasswordsCmd = &cobra.Command{
+	Use:   "migrate-passwords",
+	Short: "Move plaintext passwords out of the config file",
+	Long: `Move plaintext passwords out of the config file.
+
+Contexts created before passwords could be kept elsewhere have theirs in plaintext in
+the config file. This moves them to the keyring or the vault, and removes them from the
+config file. Contexts that opted into plaintext with '--password-store plaintext' are
+left alone unless named with --context.
+
+Example:
+
+  auth migrate-passwords --password-store vault`,
+	Run: func(cmd *cobra.Command, args []string) {
+		passwordStoreFlag, _ := cmd.Flags().GetString("password-store")
+		contextFlag, _ := cmd.Flags().GetString("context")
+
+		store, err := secrets.Resolve(passwordStoreFlag)
+		if err != nil || store == secrets.Command || store == secrets.Plaintext {
+			lwCliInst.Die(fmt.Errorf("%w: --password-store must be one of auto, keyring, vault",
+				errorTypes.LwCliInvalidFlagValue))
+		}
+
+		contexts := lwCliInst.Viper.GetStringMap("liquidweb.api.contexts")
+		if contextFlag != "" {
+			if _, exists := contexts[contextFlag]; !exists {
+				lwCliInst.Die(fmt.Errorf("context with name [%s] doesnt exist", contextFlag))
+			}
+		}
+
+		names := make([]string, 0, len(contexts))
+		for name := range contexts {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		var migrated int
+		for _, name := range names {
+			if contextFlag != "" && name != contextFlag {
+				continue
+			}
+
+			var authContext cmdTypes.AuthContext
+			if err := instance.CastFieldTypes(contexts[name], &authContext); err != nil {
+				lwCliInst.Die(err)
+			}
+
+			explicitPlaintext := authContext.PasswordStore == secrets.Plaintext && contextFlag != ""
+			if authContext.PasswordStore != "" && !explicitPlaintext {
+				continue
+			}
+			secretName := "password"
+			if authContext.UsesToken() {
+				secretName = "API token"
+			}
+			if authContext.Secret() == "" {
+				fmt.Printf("Context [%s] has no %s to move\n", name, secretName)
+				continue
+			}
+
+			authContext.PasswordStore = store
+			if err := storeAuthContextSecret(&authContext); err != nil {
+				lwCliInst.Die(err)
+			}
+			if err := config.SaveAuthContext(lwCliInst.Viper, authContext); err != nil {
+				lwCliInst.Die(err)
+			}
+
+			fmt.Printf("Moved the %s of context [%s] to the %s\n", secretName, name, store)
+			migrated++
+		}
+
+		if migrated == 0 {
+			fmt.Println("No plaintext passwords to move")
+		}
+	},
+}
+
+func init() {
+	authCmd.AddCommand(authMigratePasswordsCmd)
+
+	authMigratePasswordsCmd.Flags().String("password-store", secrets.Auto,
+		"where to move passwords to; one of auto, keyring, vault. auto is the keyring when available, else the vault")
+	authMigratePasswordsCmd.Flags().String("context", "", "only move the password of this context")
+}
@@ -20,7 +20,7 @@ import (
 
 	"github.com/spf13/cobra"
 
-	"github.com/liquidweb/liquidweb-cli/types/api"
+	"github.com/liquidweb/liquidweb-cli/utils"
 )
 
 var authPingCmd = &cobra.Command{
@@ -28,15 +28,43 @@ var authPingCmd = &cobra.Command{
 	Short: "verify api authentication",
 	Long: `Verify api authentication for current context.
 
+Reports the authentication mechanism used: a username and password sent with HTTP
+basic auth, or an API token sent as a bearer token. Token contexts also report when
+their token expires.
+
 If you've never setup any contexts, check "auth init".`,
 	Run: func(cmd *cobra.Command, args []string) {
-		var result apiTypes.UtilitiesInfoPingResponse
-		if err := lwCliInst.CallLwApiInto("bleed/utilities/info/ping",
-			map[string]interface{}{}, &result); err != nil {
+		authContext := lwCliInst.LwCliApiClient.AuthContext
+
+		result, err := lwCliInst.Api.Utilities.Info.Ping(lwCliInst.Context())
+		if err != nil {
+			if authContext != nil && authContext.UsesToken() {
+				if expiry, expired := describeTokenExpiry(*authContext); expired {
+					utils.PrintRed("the API token of context [%s] expired %s\n", authContext.ContextName, expiry)
+				}
+			}
 			lwCliInst.Die(err)
 		}
 
 		fmt.Printf("ping: %s\n", result.Ping)
+
+		if authContext == nil {
+			return
+		}
+		source := fmt.Sprintf("context [%s]", authContext.ContextName)
+		if lwCliInst.LwCliApiClient.EnvContext != nil {
+			source = fmt.Sprintf("ephemeral context [%s] from LW_* environment variables", authContext.ContextName)
+		}
+		if !authContext.UsesToken() {
+			fmt.Printf("auth: password for user [%s] via HTTP basic auth (%s)\n", authContext.Username, source)
+			return
+		}
+		fmt.Printf("auth: API token via bearer token (%s)\n", source)
+		if expiry, expired := describeTokenExpiry(*authContext); expired {
+			utils.PrintRed("token expires: %s; rotate it with 'auth update-context --token'\n", expiry)
+		} else {
+			fmt.Printf("token expires: %s\n", expiry)
+		}
 	},
 }
 
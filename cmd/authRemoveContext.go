@@ -19,6 +19,8 @@ import (
 	"fmt"
 
 	"github.com/spf13/cobra"
+
+	"github.com/liquidweb/liquidweb-cli/config"
 )
 
 var authRemoveContextCmd = &cobra.Command{
@@ -26,13 +28,21 @@ var authRemoveContextCmd = &cobra.Command{
 	Short: "Remove a context from an existing configuration",
 	Long: `Remove a context from an existing configuration.
 
-Use this if you've already setup contexts with "auth init".`,
+Use this if you've already setup contexts with "auth init". A password kept in the
+keyring or vault is removed from it as well.`,
 	Run: func(cmd *cobra.Command, args []string) {
 		contextFlag, _ := cmd.Flags().GetString("context")
 
-		if err := lwCliInst.RemoveContext(contextFlag); err != nil {
+		contexts, err := config.AuthContexts(lwCliInst.Viper)
+		if err != nil {
+			lwCliInst.Die(err)
+		}
+		authContext := contexts[contextFlag]
+
+		if err := config.RemoveAuthContext(lwCliInst.Viper, contextFlag); err != nil {
 			lwCliInst.Die(err)
 		}
+		forgetAuthContextSecret(authContext)
 
 		fmt.Printf("Removed context [%s]\n", contextFlag)
 	},
@@ -17,11 +17,15 @@ package cmd
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/spf13/cobra"
 
+	"github.com/liquidweb/liquidweb-cli/config"
 	"github.com/liquidweb/liquidweb-cli/instance"
+	"github.com/liquidweb/liquidweb-cli/secrets"
 	"github.com/liquidweb/liquidweb-cli/types/cmd"
+	"github.com/liquidweb/liquidweb-cli/types/errors"
 	"github.com/liquidweb/liquidweb-cli/validate"
 )
 
@@ -30,6 +34,13 @@ var authUpdateContextCmd = &cobra.Command{
 	Short: "Update an existing auth context",
 	Long: `Update an existing auth context.
 
+Passing --password-store or --password-command moves the password or token to that
+store, removing it from the one it was kept in.
+
+Passing --token to a token context rotates its API token, recording when it was
+rotated. The previous token's expiry is cleared unless --token-expires is given too.
+Switching --auth-type needs the new password or token passed along with it.
+
 If you've never setup any contexts, check "auth init".`,
 	Run: func(cmd *cobra.Command, args []string) {
 		contextName, _ := cmd.Flags().GetString("context")
@@ -39,9 +50,24 @@ If you've never setup any contexts, check "auth init".`,
 		timeout, _ := cmd.Flags().GetInt("timeout")
 		setInsecure, _ := cmd.Flags().GetBool("set-insecure")
 		setSecure, _ := cmd.Flags().GetBool("set-secure")
+		authType, _ := cmd.Flags().GetString("auth-type")
+		token, _ := cmd.Flags().GetString("token")
+
+		// the transport settings can be cleared by passing an empty string, so check
+		// whether they were given rather than for a sentinel value.
+		transportFlagChanged := false
+		for _, flag := range []string{"proxy", "ca-file", "client-cert", "client-key", "tls-server-name"} {
+			if cmd.Flags().Changed(flag) {
+				transportFlagChanged = true
+			}
+		}
 
-		if username == "" && password == "" && url == "" && timeout == -1 &&
-			!setInsecure && !setSecure {
+		passwordStoreChanged := cmd.Flags().Changed("password-store") || cmd.Flags().Changed("password-command")
+
+		tokenFlagChanged := authType != "" || token != "" || cmd.Flags().Changed("token-expires")
+
+		if username == "" && password == "" && url == "" && timeout == -1 && !setInsecure && !setSecure &&
+			!transportFlagChanged && !passwordStoreChanged && !tokenFlagChanged {
 			lwCliInst.Die(fmt.Errorf("must pass something to update"))
 		}
 
@@ -58,6 +84,7 @@ If you've never setup any contexts, check "auth init".`,
 		if err := instance.CastFieldTypes(contexts[contextName], &authContext); err != nil {
 			lwCliInst.Die(err)
 		}
+		previous := authContext
 
 		validateFields := map[interface{}]interface{}{}
 
@@ -65,10 +92,67 @@ If you've never setup any contexts, check "auth init".`,
 			authContext.Username = username
 			validateFields[username] = "NonEmptyString"
 		}
+
+		if token != "" && authType == "" {
+			authType = cmdTypes.AuthTypeToken
+		}
+		if authType != "" {
+			if err := validateAuthType(authType); err != nil {
+				lwCliInst.Die(err)
+			}
+			previousAuthType := cmdTypes.AuthTypePassword
+			if previous.UsesToken() {
+				previousAuthType = cmdTypes.AuthTypeToken
+			}
+			if authType != previousAuthType {
+				if password == "" && token == "" && !cmd.Flags().Changed("password-command") {
+					lwCliInst.Die(fmt.Errorf("%w: switching to --auth-type %s needs the new %s passed",
+						errorTypes.LwCliInputError, authType, authType))
+				}
+				authContext.AuthType = authType
+				authContext.Password = ""
+				authContext.Token = ""
+				authContext.TokenExpires = ""
+				authContext.TokenRotated = ""
+				if authType == cmdTypes.AuthTypePassword {
+					// unset rather than the default, matching contexts that never had a token
+					authContext.AuthType = ""
+				}
+			}
+		}
+		if authContext.UsesToken() {
+			if password != "" {
+				lwCliInst.Die(fmt.Errorf("%w: --password can't be given for a token context; see --auth-type",
+					errorTypes.LwCliInvalidFlagValue))
+			}
+			if cmd.Flags().Changed("token-expires") {
+				tokenExpiresFlag, _ := cmd.Flags().GetString("token-expires")
+				tokenExpires, err := parseTokenExpires(tokenExpiresFlag)
+				if err != nil {
+					lwCliInst.Die(err)
+				}
+				authContext.TokenExpires = tokenExpires
+			}
+		} else if token != "" || cmd.Flags().Changed("token-expires") {
+			lwCliInst.Die(fmt.Errorf("%w: --token and --token-expires are only for token contexts; see --auth-type",
+				errorTypes.LwCliInvalidFlagValue))
+		}
+
+		rotated := false
 		if password != "" {
 			authContext.Password = password
 			validateFields[password] = "NonEmptyString"
 		}
+		if token != "" {
+			authContext.Token = token
+			if previous.UsesToken() {
+				rotated = true
+				authContext.TokenRotated = time.Now().UTC().Format(time.RFC3339)
+				if !cmd.Flags().Changed("token-expires") {
+					authContext.TokenExpires = ""
+				}
+			}
+		}
 		if url != "" {
 			authContext.Url = url
 			validateFields[url] = "HttpsLiquidwebUrl"
@@ -83,25 +167,71 @@ If you've never setup any contexts, check "auth init".`,
 		if setInsecure {
 			authContext.Insecure = true
 		}
+		if cmd.Flags().Changed("proxy") {
+			authContext.Proxy, _ = cmd.Flags().GetString("proxy")
+		}
+		if cmd.Flags().Changed("ca-file") {
+			authContext.CaFile, _ = cmd.Flags().GetString("ca-file")
+		}
+		if cmd.Flags().Changed("client-cert") {
+			authContext.ClientCert, _ = cmd.Flags().GetString("client-cert")
+		}
+		if cmd.Flags().Changed("client-key") {
+			authContext.ClientKey, _ = cmd.Flags().GetString("client-key")
+		}
+		if cmd.Flags().Changed("tls-server-name") {
+			authContext.TlsServerName, _ = cmd.Flags().GetString("tls-server-name")
+		}
+
+		if cmd.Flags().Changed("password-command") {
+			authContext.PasswordCommand, _ = cmd.Flags().GetString("password-command")
+			authContext.PasswordStore = secrets.Command
+		}
+		if cmd.Flags().Changed("password-store") {
+			authContext.PasswordStore, _ = cmd.Flags().GetString("password-store")
+		}
 
 		if err := validate.Validate(validateFields); err != nil {
 			lwCliInst.Die(err)
 		}
+		if err := validateAuthContextTransport(authContext); err != nil {
+			lwCliInst.Die(err)
+		}
+
+		authContext.ContextName = contextName
 
-		lwCliInst.Viper.Set(fmt.Sprintf("liquidweb.api.contexts.%s", contextName), map[string]interface{}{
-			"contextname": contextName,
-			"username":    authContext.Username,
-			"password":    authContext.Password,
-			"url":         authContext.Url,
-			"insecure":    authContext.Insecure,
-			"timeout":     authContext.Timeout,
-		})
+		// moving to another store keeps the current secret unless a new one was given
+		newSecret := password != "" || token != ""
+		if passwordStoreChanged && !newSecret && authContext.PasswordStore != secrets.Command {
+			current, err := secrets.Password(contextName, previous.PasswordStore, previous.PasswordCommand,
+				previous.Secret())
+			if err != nil {
+				lwCliInst.Die(err)
+			}
+			authContext.SetSecret(current)
+		}
+		// contexts from before password stores keep their plaintext secret until moved
+		if newSecret || passwordStoreChanged {
+			if authContext.PasswordStore != "" {
+				if err := storeAuthContextSecret(&authContext); err != nil {
+					lwCliInst.Die(err)
+				}
+			}
+		}
 
-		if err := lwCliInst.Viper.WriteConfig(); err != nil {
+		if err := config.SaveAuthContext(lwCliInst.Viper, authContext); err != nil {
 			lwCliInst.Die(err)
 		}
 
+		if authContext.PasswordStore != previous.PasswordStore {
+			forgetAuthContextSecret(previous)
+		}
+
 		fmt.Printf("Updated context [%s]\n", contextName)
+		if rotated {
+			expiry, _ := describeTokenExpiry(authContext)
+			fmt.Printf("Rotated the API token of context [%s]; token expires: %s\n", contextName, expiry)
+		}
 	},
 }
 
@@ -115,6 +245,16 @@ func init() {
 	authUpdateContextCmd.Flags().Int("timeout", -1, "api timeout value")
 	authUpdateContextCmd.Flags().Bool("set-insecure", false, "enable insecure SSL validation of api url")
 	authUpdateContextCmd.Flags().Bool("set-secure", false, "enable secure SSL validation of api url")
+	authUpdateContextCmd.Flags().String("proxy", "", "HTTP(S) proxy URL to reach the api url through. Empty to unset")
+	authUpdateContextCmd.Flags().String("ca-file", "",
+		"PEM file of CAs to trust in addition to the system ones. Empty to unset")
+	authUpdateContextCmd.Flags().String("client-cert", "",
+		"PEM client certificate for TLS client authentication. Empty to unset")
+	authUpdateContextCmd.Flags().String("client-key", "", "PEM private key for --client-cert. Empty to unset")
+	authUpdateContextCmd.Flags().String("tls-server-name", "",
+		"server name to verify the api url certificate against. Empty to unset")
+	addPasswordStoreFlags(authUpdateContextCmd, secrets.Auto)
+	addTokenFlags(authUpdateContextCmd, "")
 
 	if err := authUpdateContextCmd.MarkFlagRequired("context"); err != nil {
 		lwCliInst.Die(err)
@@ -21,6 +21,7 @@ import (
 
 	"github.com/spf13/cobra"
 
+	"github.com/liquidweb/liquidweb-cli/config"
 	"github.com/liquidweb/liquidweb-cli/instance"
 )
 
@@ -52,8 +53,7 @@ If you've never setup any contexts, check "auth init".`,
 		}
 
 		// looks valid, set
-		lwCliInst.Viper.Set("liquidweb.api.current_context", wantedContext)
-		if err := lwCliInst.Viper.WriteConfig(); err != nil {
+		if err := config.SaveCurrentContext(lwCliInst.Viper, wantedContext); err != nil {
 			lwCliInst.Die(err)
 		}
 
@@ -0,0 +1,113 @@
+/*
+Copyright © LiquidWeb
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+
+	lwClient "github.com/liquidweb/liquidweb-cli/client"
+	apiTypes "github.com/liquidweb/liquidweb-cli/types/api"
+	"github.com/liquidweb/liquidweb-cli/types/cmd"
+	"github.com/liquidweb/liquidweb-cli/types/errors"
+)
+
+var authWhoamiCmd = &cobra.Command{
+	Use:   "whoami",
+	Short: "Show who the current context authenticates as",
+	Long: `Show who the current context authenticates as.
+
+Prints the current auth context, its API URL, the username it is configured with,
+the account number its assets belong to, and a count of the cloud servers,
+dedicated servers, volumes, object stores and load balancers on the account. These
+are counted from the categories (and types) of the assets bleed/asset/list returns;
+the json and yaml output also count every category seen.
+
+The API has no call saying which user or account a login belongs to, so the
+username is the configured one rather than confirmed by the API, and the account
+number is that of the assets listed; it is unknown (null in json) when the account
+has none.
+
+Scripts can check they are pointed at the intended account before doing anything
+destructive:
+
+  test "$(lw auth whoami -o jsonpath='{.assets_account}')" = 12345`,
+	Run: func(cmd *cobra.Command, args []string) {
+		authContext := lwCliInst.LwCliApiClient.AuthContext
+		if authContext == nil {
+			lwCliInst.Die(errorTypes.NoCurrentContext)
+		}
+
+		whoami := cmdTypes.AuthWhoami{
+			Context:            authContext.ContextName,
+			Ephemeral:          lwCliInst.LwCliApiClient.EnvContext != nil,
+			ApiUrl:             authContext.Url,
+			AuthType:           cmdTypes.AuthTypePassword,
+			ConfiguredUsername: authContext.Username,
+			Categories:         map[string]int64{},
+		}
+		if authContext.UsesToken() {
+			whoami.AuthType = cmdTypes.AuthTypeToken
+		}
+
+		listReq := &lwClient.AssetListRequest{
+			ListOptions: lwClient.ListOptions{PageSize: 100},
+			AlsoWith:    []string{"categories"},
+		}
+		err := lwCliInst.Api.Asset.Each(lwCliInst.Context(), listReq, func(asset *apiTypes.Subaccnt) (bool, error) {
+			if whoami.AssetsAccount == nil {
+				account := asset.Accnt
+				whoami.AssetsAccount = &account
+			}
+			for _, category := range asset.Categories {
+				whoami.Categories[category]++
+			}
+			countWhoamiAsset(&whoami.Resources, asset)
+			return true, nil
+		})
+		if err != nil {
+			lwCliInst.Die(err)
+		}
+
+		printResult(cmd, whoami)
+	},
+}
+
+// countWhoamiAsset adds asset to the count of the resource it is, if any. Categories
+// single out dedicated servers and load balancers; the rest go by asset type, as
+// their listings elsewhere do.
+func countWhoamiAsset(resources *cmdTypes.AuthWhoamiResources, asset *apiTypes.Subaccnt) {
+	categories := map[string]bool{}
+	for _, category := range asset.Categories {
+		categories[category] = true
+	}
+
+	switch {
+	case categories["StrictDedicated"]:
+		resources.DedicatedServers++
+	case categories["LoadBalancer"]:
+		resources.LoadBalancers++
+	case asset.Type == "SS.ObjectStore":
+		resources.ObjectStores++
+	case asset.Type == "SS.SBS":
+		resources.Volumes++
+	case asset.Type == "SS.VPS" || asset.Type == "SS.VPS.WIN" || asset.Type == "SS.VM" || asset.Type == "SS.VM.WIN":
+		resources.CloudServers++
+	}
+}
+
+func init() {
+	authCmd.AddCommand(authWhoamiCmd)
+}
@@ -0,0 +1,50 @@
+/*
+Copyright © LiquidWeb
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package cmd
+
+import (
+	"os"
+
+	"github.com/spf13/cobra"
+)
+
+var cacheCmd = &cobra.Command{
+	Use:   "cache",
+	Short: "Manage the local API response cache",
+	Long: `Manage the local API response cache.
+
+Slow-changing catalog data (configs, templates, zones, regions, load balancer
+strategies and the list of private parents) is cached on disk per auth context, API URL
+and username, so repeated commands such as 'cloud server options' or a resize
+onto a private parent don't refetch it on every invocation. Changing a private
+parent, or an instance on one, through lw drops the cached list. A private
+parent's details report its free and used resources, so are never cached. Cached entries
+expire after an hour by default; set 'liquidweb.cache.ttl' (in seconds) in
+your config file to change this. Pass --no-cache to any command to bypass the
+cache for that invocation.
+
+For a full list of capabilities, please refer to the "Available Commands" section.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		if err := cmd.Help(); err != nil {
+			lwCliInst.Die(err)
+		}
+		os.Exit(1)
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(cacheCmd)
+}
@@ -0,0 +1,43 @@
+/*
+Copyright © LiquidWeb
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/liquidweb/liquidweb-cli/cache"
+)
+
+var cacheClearCmd = &cobra.Command{
+	Use:   "clear",
+	Short: "Remove all cached API responses",
+	Long: `Remove all cached API responses.
+
+The next command needing catalog data will fetch it fresh from the API.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		if err := cache.Clear(); err != nil {
+			lwCliInst.Die(err)
+		}
+
+		fmt.Println("Cache cleared.")
+	},
+}
+
+func init() {
+	cacheCmd.AddCommand(cacheClearCmd)
+}
@@ -16,11 +16,7 @@ limitations under the License.
 package cmd
 
 import (
-	"fmt"
-
 	"github.com/spf13/cobra"
-
-	"github.com/liquidweb/liquidweb-cli/types/api"
 )
 
 var cloudBackupDetailsCmd = &cobra.Command{
@@ -30,15 +26,12 @@ var cloudBackupDetailsCmd = &cobra.Command{
 	Run: func(cmd *cobra.Command, args []string) {
 		backupIdFlag, _ := cmd.Flags().GetInt64("backup-id")
 
-		apiArgs := map[string]interface{}{"id": backupIdFlag}
-
-		var details apiTypes.CloudBackupDetails
-		err := lwCliInst.CallLwApiInto("bleed/storm/backup/details", apiArgs, &details)
+		details, err := lwCliInst.Api.Storm.Backup.Details(lwCliInst.Context(), backupIdFlag)
 		if err != nil {
 			lwCliInst.Die(err)
 		}
 
-		fmt.Print(details)
+		printResult(cmd, details)
 	},
 }
 
@@ -16,12 +16,10 @@ limitations under the License.
 package cmd
 
 import (
-	"fmt"
-	"os"
-
 	"github.com/spf13/cobra"
 
-	"github.com/liquidweb/liquidweb-cli/instance"
+	lwClient "github.com/liquidweb/liquidweb-cli/client"
+	"github.com/liquidweb/liquidweb-cli/output"
 	"github.com/liquidweb/liquidweb-cli/types/api"
 	"github.com/liquidweb/liquidweb-cli/validate"
 )
@@ -31,7 +29,6 @@ var cloudBackupListCmd = &cobra.Command{
 	Short: "List Cloud Backups on your account",
 	Long:  `List Cloud Backups on your account`,
 	Run: func(cmd *cobra.Command, args []string) {
-		jsonFlag, _ := cmd.Flags().GetBool("json")
 		uniqIdFlag, _ := cmd.Flags().GetString("uniq-id")
 
 		if uniqIdFlag != "" {
@@ -44,37 +41,40 @@ var cloudBackupListCmd = &cobra.Command{
 			}
 		}
 
-		methodArgs := instance.AllPaginatedResultsArgs{
-			Method:         "bleed/storm/backup/list",
-			ResultsPerPage: 100,
-		}
-		results, err := lwCliInst.AllPaginatedResults(&methodArgs)
-		if err != nil {
-			lwCliInst.Die(err)
-		}
+		listQuery := newListQuery(cmd)
+		listOpts := lwClient.ListOptions{PageSize: 100}
 
-		if jsonFlag {
-			pretty, err := lwCliInst.JsonEncodeAndPrettyPrint(results)
+		printer := newListPrinter(cmd)
+		if printer.Format.Encoded() {
+			listOpts.Limit = listQuery.ListLimit()
+			results, err := lwCliInst.Api.Storm.Backup.All(lwCliInst.Context(), listOpts)
 			if err != nil {
 				lwCliInst.Die(err)
 			}
-			fmt.Printf(pretty)
-			os.Exit(0)
-		}
-
-		for _, item := range results.Items {
-			var details apiTypes.CloudBackupDetails
-			if err := instance.CastFieldTypes(item, &details); err != nil {
+			if results.Items, err = listQuery.Items(results.Items); err != nil {
 				lwCliInst.Die(err)
 			}
-
-			if uniqIdFlag != "" {
-				if details.UniqId != uniqIdFlag {
-					continue
-				}
+			if err := printer.Print(results); err != nil {
+				lwCliInst.Die(err)
 			}
+			return
+		}
 
-			fmt.Print(details)
+		err := listQuery.Run(func(visit output.ItemFunc) error {
+			return lwCliInst.Api.Storm.Backup.Each(lwCliInst.Context(), listOpts, func(details *apiTypes.CloudBackupDetails) (bool, error) {
+				if uniqIdFlag != "" && details.UniqId != uniqIdFlag {
+					return true, nil
+				}
+				return visit(details)
+			})
+		}, func(item interface{}) (bool, error) {
+			return true, printer.Add(item)
+		})
+		if err != nil {
+			lwCliInst.Die(err)
+		}
+		if err := printer.Flush(); err != nil {
+			lwCliInst.Die(err)
 		}
 	},
 }
@@ -82,6 +82,8 @@ var cloudBackupListCmd = &cobra.Command{
 func init() {
 	cloudBackupCmd.AddCommand(cloudBackupListCmd)
 
-	cloudBackupListCmd.Flags().Bool("json", false, "output in json format")
+	cloudBackupListCmd.Flags().Bool("json", false, "output in json format (same as --output json)")
 	cloudBackupListCmd.Flags().String("uniq-id", "", "only fetch backups made from this uniq-id")
+	cloudBackupListCmd.Flags().Int64("limit", 0, "stop after listing this many Cloud Backups (0 for no limit)")
+	addListQueryFlags(cloudBackupListCmd)
 }
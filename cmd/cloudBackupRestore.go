@@ -20,7 +20,6 @@ import (
 
 	"github.com/spf13/cobra"
 
-	"github.com/liquidweb/liquidweb-cli/types/api"
 	"github.com/liquidweb/liquidweb-cli/validate"
 )
 
@@ -29,44 +28,39 @@ var cloudBackupRestoreCmd = &cobra.Command{
 	Short: "Restore a Cloud Backup on a Cloud Server",
 	Long:  `Restore a Cloud Backup on a Cloud Server.`,
 	Run: func(cmd *cobra.Command, args []string) {
-		uniqIdFlag, _ := cmd.Flags().GetString("uniq-id")
 		rebuildFsFlag, _ := cmd.Flags().GetBool("rebuild-fs")
 		backupIdFlag, _ := cmd.Flags().GetInt64("backup-id")
 
-		validateFields := map[interface{}]interface{}{
-			uniqIdFlag:   "UniqId",
-			backupIdFlag: "PositiveInt64",
-		}
-		if err := validate.Validate(validateFields); err != nil {
-			lwCliInst.Die(err)
-		}
+		runTargets(requireTargets(cmd), func(uniqId string) error {
+			validateFields := map[interface{}]interface{}{
+				uniqId:       "UniqId",
+				backupIdFlag: "PositiveInt64",
+			}
+			if err := validate.Validate(validateFields); err != nil {
+				return err
+			}
 
-		apiArgs := map[string]interface{}{"id": backupIdFlag, "uniq_id": uniqIdFlag}
-		if rebuildFsFlag {
-			apiArgs["force"] = 1
-		}
+			details, err := lwCliInst.Api.Storm.Backup.Restore(lwCliInst.Context(), backupIdFlag, uniqId,
+				rebuildFsFlag)
+			if err != nil {
+				return err
+			}
 
-		var details apiTypes.CloudBackupRestoreResponse
-		err := lwCliInst.CallLwApiInto("bleed/storm/backup/restore", apiArgs, &details)
-		if err != nil {
-			lwCliInst.Die(err)
-		}
+			fmt.Printf("Restoring backup! %+v\n", *details)
+			fmt.Printf("\tcheck progress with 'cloud server status --uniq-id %s'\n", uniqId)
 
-		fmt.Printf("Restoring backup! %+v\n", details)
-		fmt.Printf("\tcheck progress with 'cloud server status --uniq-id %s'\n", uniqIdFlag)
+			return nil
+		})
 	},
 }
 
 func init() {
 	cloudBackupCmd.AddCommand(cloudBackupRestoreCmd)
 
-	cloudBackupRestoreCmd.Flags().String("uniq-id", "", "uniq-id of Cloud Server")
+	addTargetFlags(cloudBackupRestoreCmd, "uniq-id of Cloud Server")
 	cloudBackupRestoreCmd.Flags().Int64("backup-id", -1, "id of the Cloud Backup")
 	cloudBackupRestoreCmd.Flags().Bool("rebuild-fs", false, "rebuild filesystem before restoring")
 
-	if err := cloudBackupRestoreCmd.MarkFlagRequired("uniq-id"); err != nil {
-		lwCliInst.Die(err)
-	}
 	if err := cloudBackupRestoreCmd.MarkFlagRequired("backup-id"); err != nil {
 		lwCliInst.Die(err)
 	}
@@ -20,7 +20,7 @@ import (
 
 	"github.com/spf13/cobra"
 
-	"github.com/liquidweb/liquidweb-cli/types/api"
+	"github.com/liquidweb/liquidweb-cli/output"
 	"github.com/liquidweb/liquidweb-cli/validate"
 )
 
@@ -29,39 +29,41 @@ var cloudImageCreateCmd = &cobra.Command{
 	Short: "Create a Cloud Image",
 	Long:  `Create a Cloud Image.`,
 	Run: func(cmd *cobra.Command, args []string) {
-		uniqIdFlag, _ := cmd.Flags().GetString("uniq-id")
 		nameFlag, _ := cmd.Flags().GetString("name")
 
-		validateFields := map[interface{}]interface{}{
-			uniqIdFlag: "UniqId",
-			nameFlag:   "NonEmptyString",
-		}
-		if err := validate.Validate(validateFields); err != nil {
-			lwCliInst.Die(err)
-		}
+		runTargets(requireTargets(cmd), func(uniqId string) error {
+			validateFields := map[interface{}]interface{}{
+				uniqId:   "UniqId",
+				nameFlag: "NonEmptyString",
+			}
+			if err := validate.Validate(validateFields); err != nil {
+				return err
+			}
 
-		apiArgs := map[string]interface{}{"name": nameFlag, "uniq_id": uniqIdFlag}
+			details, err := lwCliInst.Api.Storm.Image.Create(lwCliInst.Context(), uniqId, nameFlag)
+			if err != nil {
+				return err
+			}
 
-		var details apiTypes.CloudImageCreateResponse
-		err := lwCliInst.CallLwApiInto("bleed/storm/image/create", apiArgs, &details)
-		if err != nil {
-			lwCliInst.Die(err)
-		}
+			if cmdOutputFormat(cmd) != output.Text {
+				printResult(cmd, details)
+				return nil
+			}
 
-		fmt.Printf("Creating image! %+v\n", details)
-		fmt.Printf("\tthe Cloud Image will not appear in 'cloud image list' until complete\n")
+			fmt.Printf("Creating image! %+v\n", *details)
+			fmt.Printf("\tthe Cloud Image will not appear in 'cloud image list' until complete\n")
+
+			return nil
+		})
 	},
 }
 
 func init() {
 	cloudImageCmd.AddCommand(cloudImageCreateCmd)
 
-	cloudImageCreateCmd.Flags().String("uniq-id", "", "uniq-id of Cloud Server")
+	addTargetFlags(cloudImageCreateCmd, "uniq-id of Cloud Server")
 	cloudImageCreateCmd.Flags().String("name", "", "name for the Cloud Image")
 
-	if err := cloudImageCreateCmd.MarkFlagRequired("uniq-id"); err != nil {
-		lwCliInst.Die(err)
-	}
 	if err := cloudImageCreateCmd.MarkFlagRequired("name"); err != nil {
 		lwCliInst.Die(err)
 	}
@@ -20,8 +20,6 @@ import (
 	"os"
 
 	"github.com/spf13/cobra"
-
-	"github.com/liquidweb/liquidweb-cli/types/api"
 )
 
 var cloudImageDeleteCmd = &cobra.Command{
@@ -40,10 +38,7 @@ var cloudImageDeleteCmd = &cobra.Command{
 			}
 		}
 
-		apiArgs := map[string]interface{}{"id": imageIdFlag}
-
-		var details apiTypes.CloudImageDeleteResponse
-		err := lwCliInst.CallLwApiInto("bleed/storm/image/delete", apiArgs, &details)
+		details, err := lwCliInst.Api.Storm.Image.Delete(lwCliInst.Context(), imageIdFlag)
 		if err != nil {
 			lwCliInst.Die(err)
 		}
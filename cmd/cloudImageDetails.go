@@ -16,11 +16,7 @@ limitations under the License.
 package cmd
 
 import (
-	"fmt"
-
 	"github.com/spf13/cobra"
-
-	"github.com/liquidweb/liquidweb-cli/types/api"
 )
 
 var cloudImageDetailsCmd = &cobra.Command{
@@ -30,15 +26,12 @@ var cloudImageDetailsCmd = &cobra.Command{
 	Run: func(cmd *cobra.Command, args []string) {
 		imageIdFlag, _ := cmd.Flags().GetInt64("image-id")
 
-		apiArgs := map[string]interface{}{"id": imageIdFlag}
-
-		var details apiTypes.CloudImageDetails
-		err := lwCliInst.CallLwApiInto("bleed/storm/image/details", apiArgs, &details)
+		details, err := lwCliInst.Api.Storm.Image.Details(lwCliInst.Context(), imageIdFlag)
 		if err != nil {
 			lwCliInst.Die(err)
 		}
 
-		fmt.Print(details)
+		printResult(cmd, details)
 	},
 }
 
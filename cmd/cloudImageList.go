@@ -16,12 +16,10 @@ limitations under the License.
 package cmd
 
 import (
-	"fmt"
-	"os"
-
 	"github.com/spf13/cobra"
 
-	"github.com/liquidweb/liquidweb-cli/instance"
+	lwClient "github.com/liquidweb/liquidweb-cli/client"
+	"github.com/liquidweb/liquidweb-cli/output"
 	"github.com/liquidweb/liquidweb-cli/types/api"
 )
 
@@ -30,32 +28,39 @@ var cloudImageListCmd = &cobra.Command{
 	Short: "List Cloud Images on your account",
 	Long:  `List Cloud Images on your account`,
 	Run: func(cmd *cobra.Command, args []string) {
-		jsonFlag, _ := cmd.Flags().GetBool("json")
-		methodArgs := instance.AllPaginatedResultsArgs{
-			Method:         "bleed/storm/image/list",
-			ResultsPerPage: 100,
-		}
-		results, err := lwCliInst.AllPaginatedResults(&methodArgs)
-		if err != nil {
-			lwCliInst.Die(err)
+		listQuery := newListQuery(cmd)
+		listOpts := lwClient.ListOptions{
+			PageSize: 100,
+			Limit:    listQuery.ListLimit(),
 		}
 
-		if jsonFlag {
-			pretty, err := lwCliInst.JsonEncodeAndPrettyPrint(results)
+		printer := newListPrinter(cmd)
+		if printer.Format.Encoded() {
+			results, err := lwCliInst.Api.Storm.Image.All(lwCliInst.Context(), listOpts)
 			if err != nil {
 				lwCliInst.Die(err)
 			}
-			fmt.Printf(pretty)
-			os.Exit(0)
-		}
-
-		for _, item := range results.Items {
-			var details apiTypes.CloudImageDetails
-			if err := instance.CastFieldTypes(item, &details); err != nil {
+			if results.Items, err = listQuery.Items(results.Items); err != nil {
 				lwCliInst.Die(err)
 			}
+			if err := printer.Print(results); err != nil {
+				lwCliInst.Die(err)
+			}
+			return
+		}
 
-			fmt.Print(details)
+		err := listQuery.Run(func(visit output.ItemFunc) error {
+			return lwCliInst.Api.Storm.Image.Each(lwCliInst.Context(), listOpts, func(details *apiTypes.CloudImageDetails) (bool, error) {
+				return visit(details)
+			})
+		}, func(item interface{}) (bool, error) {
+			return true, printer.Add(item)
+		})
+		if err != nil {
+			lwCliInst.Die(err)
+		}
+		if err := printer.Flush(); err != nil {
+			lwCliInst.Die(err)
 		}
 	},
 }
@@ -63,5 +68,7 @@ var cloudImageListCmd = &cobra.Command{
 func init() {
 	cloudImageCmd.AddCommand(cloudImageListCmd)
 
-	cloudImageListCmd.Flags().Bool("json", false, "output in json format")
+	cloudImageListCmd.Flags().Bool("json", false, "output in json format (same as --output json)")
+	cloudImageListCmd.Flags().Int64("limit", 0, "stop after listing this many Cloud Images (0 for no limit)")
+	addListQueryFlags(cloudImageListCmd)
 }
@@ -19,8 +19,6 @@ import (
 	"fmt"
 
 	"github.com/spf13/cobra"
-
-	"github.com/liquidweb/liquidweb-cli/types/api"
 )
 
 var cloudImageRenameCmd = &cobra.Command{
@@ -31,10 +29,7 @@ var cloudImageRenameCmd = &cobra.Command{
 		imageIdFlag, _ := cmd.Flags().GetInt64("image-id")
 		nameFlag, _ := cmd.Flags().GetString("name")
 
-		apiArgs := map[string]interface{}{"id": imageIdFlag, "name": nameFlag}
-
-		var details apiTypes.CloudImageDetails
-		err := lwCliInst.CallLwApiInto("bleed/storm/image/update", apiArgs, &details)
+		details, err := lwCliInst.Api.Storm.Image.Update(lwCliInst.Context(), imageIdFlag, nameFlag)
 		if err != nil {
 			lwCliInst.Die(err)
 		}
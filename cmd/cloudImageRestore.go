@@ -20,7 +20,6 @@ import (
 
 	"github.com/spf13/cobra"
 
-	"github.com/liquidweb/liquidweb-cli/types/api"
 	"github.com/liquidweb/liquidweb-cli/validate"
 )
 
@@ -29,44 +28,39 @@ var cloudImageRestoreCmd = &cobra.Command{
 	Short: "Restore a Cloud Image on a Cloud Server",
 	Long:  `Restore a Cloud Image on a Cloud Server.`,
 	Run: func(cmd *cobra.Command, args []string) {
-		uniqIdFlag, _ := cmd.Flags().GetString("uniq-id")
 		rebuildFsFlag, _ := cmd.Flags().GetBool("rebuild-fs")
 		imageIdFlag, _ := cmd.Flags().GetInt64("image-id")
 
-		validateFields := map[interface{}]interface{}{
-			uniqIdFlag:  "UniqId",
-			imageIdFlag: "PositiveInt64",
-		}
-		if err := validate.Validate(validateFields); err != nil {
-			lwCliInst.Die(err)
-		}
+		runTargets(requireTargets(cmd), func(uniqId string) error {
+			validateFields := map[interface{}]interface{}{
+				uniqId:      "UniqId",
+				imageIdFlag: "PositiveInt64",
+			}
+			if err := validate.Validate(validateFields); err != nil {
+				return err
+			}
 
-		apiArgs := map[string]interface{}{"id": imageIdFlag, "uniq_id": uniqIdFlag}
-		if rebuildFsFlag {
-			apiArgs["force"] = 1
-		}
+			details, err := lwCliInst.Api.Storm.Image.Restore(lwCliInst.Context(), imageIdFlag, uniqId,
+				rebuildFsFlag)
+			if err != nil {
+				return err
+			}
 
-		var details apiTypes.CloudImageRestoreResponse
-		err := lwCliInst.CallLwApiInto("bleed/storm/image/restore", apiArgs, &details)
-		if err != nil {
-			lwCliInst.Die(err)
-		}
+			fmt.Printf("Restoring image! %+v\n", *details)
+			fmt.Printf("\tcheck progress with 'cloud server status --uniq-id %s'\n", uniqId)
 
-		fmt.Printf("Restoring image! %+v\n", details)
-		fmt.Printf("\tcheck progress with 'cloud server status --uniq-id %s'\n", uniqIdFlag)
+			return nil
+		})
 	},
 }
 
 func init() {
 	cloudImageCmd.AddCommand(cloudImageRestoreCmd)
 
-	cloudImageRestoreCmd.Flags().String("uniq-id", "", "uniq-id of Cloud Server")
+	addTargetFlags(cloudImageRestoreCmd, "uniq-id of Cloud Server")
 	cloudImageRestoreCmd.Flags().Int64("image-id", -1, "id of the Cloud Image")
 	cloudImageRestoreCmd.Flags().Bool("rebuild-fs", false, "rebuild filesystem before restoring")
 
-	if err := cloudImageRestoreCmd.MarkFlagRequired("uniq-id"); err != nil {
-		lwCliInst.Die(err)
-	}
 	if err := cloudImageRestoreCmd.MarkFlagRequired("image-id"); err != nil {
 		lwCliInst.Die(err)
 	}
@@ -23,8 +23,6 @@ import (
 	"github.com/liquidweb/liquidweb-cli/instance"
 )
 
-var cloudNetworkPrivateAttachCmdUniqIdFlag []string
-
 var cloudNetworkPrivateAttachCmd = &cobra.Command{
 	Use:   "attach",
 	Short: "Attach a Cloud Server to a Private Network",
@@ -40,24 +38,24 @@ Applications that communicate internally will frequently use this for both secur
 and cost-savings.
 `,
 	Run: func(cmd *cobra.Command, args []string) {
-		params := &instance.CloudNetworkPrivateAttachParams{}
+		runTargets(requireTargets(cmd), func(uniqId string) error {
+			params := &instance.CloudNetworkPrivateAttachParams{
+				UniqId: []string{uniqId},
+			}
 
-		params.UniqId = cloudNetworkPrivateAttachCmdUniqIdFlag
+			status, err := lwCliInst.CloudNetworkPrivateAttach(params)
+			if err != nil {
+				return err
+			}
 
-		status, err := lwCliInst.CloudNetworkPrivateAttach(params)
-		if err != nil {
-			lwCliInst.Die(err)
-		}
+			fmt.Print(status)
 
-		fmt.Print(status)
+			return nil
+		})
 	},
 }
 
 func init() {
 	cloudNetworkPrivateCmd.AddCommand(cloudNetworkPrivateAttachCmd)
-	cloudNetworkPrivateAttachCmd.Flags().StringSliceVar(&cloudNetworkPrivateAttachCmdUniqIdFlag, "uniq-id",
-		[]string{}, "uniq-ids separated by ',' of Cloud Servers to attach to private networking")
-	if err := cloudNetworkPrivateAttachCmd.MarkFlagRequired("uniq-id"); err != nil {
-		lwCliInst.Die(err)
-	}
+	addTargetFlags(cloudNetworkPrivateAttachCmd, "uniq-id of the Cloud Server to attach to private networking")
 }
@@ -23,8 +23,6 @@ import (
 	"github.com/liquidweb/liquidweb-cli/instance"
 )
 
-var cloudNetworkPrivateDetachCmdUniqIdFlag []string
-
 var cloudNetworkPrivateDetachCmd = &cobra.Command{
 	Use:   "detach",
 	Short: "Detach a Cloud Server from a Private Network",
@@ -40,24 +38,24 @@ Applications that communicate internally will frequently use this for both secur
 and cost-savings.
 `,
 	Run: func(cmd *cobra.Command, args []string) {
-		params := &instance.CloudNetworkPrivateDetachParams{}
+		runTargets(requireTargets(cmd), func(uniqId string) error {
+			params := &instance.CloudNetworkPrivateDetachParams{
+				UniqId: []string{uniqId},
+			}
 
-		params.UniqId = cloudNetworkPrivateDetachCmdUniqIdFlag
+			status, err := lwCliInst.CloudNetworkPrivateDetach(params)
+			if err != nil {
+				return err
+			}
 
-		status, err := lwCliInst.CloudNetworkPrivateDetach(params)
-		if err != nil {
-			lwCliInst.Die(err)
-		}
+			fmt.Print(status)
 
-		fmt.Print(status)
+			return nil
+		})
 	},
 }
 
 func init() {
 	cloudNetworkPrivateCmd.AddCommand(cloudNetworkPrivateDetachCmd)
-	cloudNetworkPrivateDetachCmd.Flags().StringSliceVar(&cloudNetworkPrivateDetachCmdUniqIdFlag, "uniq-id",
-		[]string{}, "uniq-ids separated by ',' of Cloud Servers to detach from private networking")
-	if err := cloudNetworkPrivateDetachCmd.MarkFlagRequired("uniq-id"); err != nil {
-		lwCliInst.Die(err)
-	}
+	addTargetFlags(cloudNetworkPrivateDetachCmd, "uniq-id of the Cloud Server to detach from private networking")
 }
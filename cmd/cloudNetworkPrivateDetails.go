@@ -16,17 +16,13 @@ limitations under the License.
 package cmd
 
 import (
-	"fmt"
-
 	"github.com/spf13/cobra"
 
-	"github.com/liquidweb/liquidweb-cli/instance"
+	lwClient "github.com/liquidweb/liquidweb-cli/client"
 	"github.com/liquidweb/liquidweb-cli/types/api"
 	"github.com/liquidweb/liquidweb-cli/validate"
 )
 
-var cloudNetworkPrivateDetailsCmdUniqIdFlag []string
-
 var cloudNetworkPrivateDetailsCmd = &cobra.Command{
 	Use:   "details",
 	Short: "Get Private Network details for a single or all Cloud Server(s)",
@@ -42,50 +38,40 @@ Applications that communicate internally will frequently use this for both secur
 and cost-savings.
 `,
 	Run: func(cmd *cobra.Command, args []string) {
-		var uniqIds []string
-
-		if len(cloudNetworkPrivateDetailsCmdUniqIdFlag) == 0 {
-			methodArgs := instance.AllPaginatedResultsArgs{
-				Method:         "bleed/storm/server/list",
-				ResultsPerPage: 100,
-			}
-			results, err := lwCliInst.AllPaginatedResults(&methodArgs)
+		uniqIds := cmdTargets(cmd)
+
+		if len(uniqIds) == 0 {
+			err := lwCliInst.Api.Storm.Server.Each(lwCliInst.Context(), lwClient.ListOptions{PageSize: 100},
+				func(cs *apiTypes.CloudServerDetails) (bool, error) {
+					uniqIds = append(uniqIds, cs.UniqId)
+					return true, nil
+				})
 			if err != nil {
 				lwCliInst.Die(err)
 			}
-			for _, item := range results.Items {
-				var cs apiTypes.CloudServerDetails
-				if err := instance.CastFieldTypes(item, &cs); err != nil {
-					lwCliInst.Die(err)
-				}
-				uniqIds = append(uniqIds, cs.UniqId)
-			}
-		} else {
-			uniqIds = cloudNetworkPrivateDetailsCmdUniqIdFlag
 		}
 
-		for _, uniqId := range uniqIds {
+		printer := newPrinter(cmd)
+		runPrintedTargets(printer, uniqIds, func(uniqId string) error {
 			validateFields := map[interface{}]interface{}{
 				uniqId: "UniqId",
 			}
 			if err := validate.Validate(validateFields); err != nil {
-				lwCliInst.Die(err)
+				return err
 			}
 
-			apiArgs := map[string]interface{}{"uniq_id": uniqId}
-
-			var details apiTypes.CloudNetworkPrivateGetIpResponse
-			if err := lwCliInst.CallLwApiInto("bleed/network/private/getip", apiArgs, &details); err != nil {
-				lwCliInst.Die(err)
+			details, err := lwCliInst.Api.Network.Private.GetIp(lwCliInst.Context(), uniqId)
+			if err != nil {
+				return err
 			}
 
-			fmt.Print(details)
-		}
+			return printer.Print(details)
+		})
 	},
 }
 
 func init() {
 	cloudNetworkPrivateCmd.AddCommand(cloudNetworkPrivateDetailsCmd)
-	cloudNetworkPrivateDetailsCmd.Flags().StringSliceVar(&cloudNetworkPrivateDetailsCmdUniqIdFlag, "uniq-id",
-		[]string{}, "uniq-ids separated by ',' of Cloud Servers to fetch private networking details for")
+	addTargetFlags(cloudNetworkPrivateDetailsCmd,
+		"uniq-id of the Cloud Server to fetch private networking details for; all when not given")
 }
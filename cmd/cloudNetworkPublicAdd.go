@@ -45,27 +45,31 @@ IPv6 Notes:
 Only /64s will be given out. There is a limit of one /64 per Cloud Server. If
 you need more than this, you can contact support.`,
 	Run: func(cmd *cobra.Command, args []string) {
-		params := &instance.CloudNetworkPublicAddParams{}
+		runTargets(requireTargets(cmd), func(uniqId string) error {
+			params := &instance.CloudNetworkPublicAddParams{}
 
-		params.UniqId, _ = cmd.Flags().GetString("uniq-id")
-		params.ConfigureIps, _ = cmd.Flags().GetBool("configure-ips")
-		params.NewIps, _ = cmd.Flags().GetInt64("new-ips")
-		params.NewIp6s, _ = cmd.Flags().GetInt64("new-ip6s")
-		params.PoolIps = cloudNetworkPublicAddCmdPoolIpsFlag
-		params.Pool6Ips = cloudNetworkPublicAddCmdPool6IpsFlag
+			params.UniqId = uniqId
+			params.ConfigureIps, _ = cmd.Flags().GetBool("configure-ips")
+			params.NewIps, _ = cmd.Flags().GetInt64("new-ips")
+			params.NewIp6s, _ = cmd.Flags().GetInt64("new-ip6s")
+			params.PoolIps = cloudNetworkPublicAddCmdPoolIpsFlag
+			params.Pool6Ips = cloudNetworkPublicAddCmdPool6IpsFlag
 
-		status, err := lwCliInst.CloudNetworkPublicAdd(params)
-		if err != nil {
-			lwCliInst.Die(err)
-		}
+			status, err := lwCliInst.CloudNetworkPublicAdd(params)
+			if err != nil {
+				return err
+			}
 
-		fmt.Print(status)
+			fmt.Print(status)
+
+			return nil
+		})
 	},
 }
 
 func init() {
 	cloudNetworkPublicCmd.AddCommand(cloudNetworkPublicAddCmd)
-	cloudNetworkPublicAddCmd.Flags().String("uniq-id", "", "uniq-id of the Cloud Server")
+	addTargetFlags(cloudNetworkPublicAddCmd, "uniq-id of the Cloud Server")
 	cloudNetworkPublicAddCmd.Flags().Bool("configure-ips", false,
 		"whether or not to automatically configure the new IP address(es) in the server")
 	cloudNetworkPublicAddCmd.Flags().Int64("new-ips", 0, "amount of new IPv4 ips to (randomly) grab")
@@ -74,8 +78,4 @@ func init() {
 		"IPv4 ips from your IP Pool separated by ',' to assign to the Cloud Server")
 	cloudNetworkPublicAddCmd.Flags().StringSliceVar(&cloudNetworkPublicAddCmdPool6IpsFlag, "pool6-ips", []string{},
 		"IPv6 assignments from your IP Pool separated by ',' to assign to the Cloud Server")
-
-	if err := cloudNetworkPublicAddCmd.MarkFlagRequired("uniq-id"); err != nil {
-		lwCliInst.Die(err)
-	}
 }
@@ -20,7 +20,8 @@ import (
 
 	"github.com/spf13/cobra"
 
-	"github.com/liquidweb/liquidweb-cli/instance"
+	lwClient "github.com/liquidweb/liquidweb-cli/client"
+	"github.com/liquidweb/liquidweb-cli/output"
 	"github.com/liquidweb/liquidweb-cli/types/api"
 )
 
@@ -31,54 +32,50 @@ var cloudNetworkPublicListCmd = &cobra.Command{
 	Short: "List a Cloud Servers Public IP(s)",
 	Long:  `List a Cloud Servers Public IP(s).`,
 	Run: func(cmd *cobra.Command, args []string) {
-		uniqIdFlag, _ := cmd.Flags().GetString("uniq-id")
-
-		methodArgs := instance.AllPaginatedResultsArgs{
-			Method:         "bleed/network/ip/list",
-			ResultsPerPage: 100,
-			MethodArgs: map[string]interface{}{
-				"uniq_id":    uniqIdFlag,
-				"expand_ips": 1,
-			},
-		}
-		results, err := lwCliInst.AllPaginatedResults(&methodArgs)
-		if err != nil {
-			lwCliInst.Die(err)
-		}
-
-		fmt.Printf("IP Assignments for %s:\n\n", uniqIdFlag)
+		printer := newListPrinter(cmd)
+		runPrintedTargets(printer, requireTargets(cmd), func(uniqId string) error {
+			if printer.Format == output.Text {
+				fmt.Printf("IP Assignments for %s:\n\n", uniqId)
+			}
 
-		var private apiTypes.CloudNetworkPrivateGetIpResponse
-		privateArgs := map[string]interface{}{"uniq_id": uniqIdFlag}
-		if err := lwCliInst.CallLwApiInto("bleed/network/private/getip", privateArgs, &private); err != nil {
-			lwCliInst.Die(err)
-		}
+			private, err := lwCliInst.Api.Network.Private.GetIp(lwCliInst.Context(), uniqId)
+			if err != nil {
+				return err
+			}
 
-		for c, item := range results.Items {
-			var details apiTypes.NetworkAssignmentListEntry
-			if err := instance.CastFieldTypes(item, &details); err != nil {
-				lwCliInst.Die(err)
+			var c int
+			ipListReq := &lwClient.NetworkIpListRequest{
+				ListOptions: lwClient.ListOptions{PageSize: 100},
+				UniqId:      uniqId,
+				ExpandIps:   true,
 			}
+			err = lwCliInst.Api.Network.Ip.Each(lwCliInst.Context(), ipListReq, func(details *apiTypes.NetworkAssignmentListEntry) (bool, error) {
+				if printer.Format != output.Text {
+					return true, printer.Add(details)
+				}
 
-			// first ip is always primary
-			if c == 0 {
-				fmt.Println("Primary IP:")
-			} else {
-				if details.Ip == private.Ip {
-					fmt.Println("Private Network IP:")
+				// first ip is always primary
+				if c == 0 {
+					fmt.Println("Primary IP:")
 				} else {
-					fmt.Println("Secondary IP:")
+					if details.Ip == private.Ip {
+						fmt.Println("Private Network IP:")
+					} else {
+						fmt.Println("Secondary IP:")
+					}
 				}
-			}
-			fmt.Print(details)
-		}
+				fmt.Print(details)
+				c++
+
+				return true, nil
+			})
+
+			return err
+		})
 	},
 }
 
 func init() {
 	cloudNetworkPublicCmd.AddCommand(cloudNetworkPublicListCmd)
-	cloudNetworkPublicListCmd.Flags().String("uniq-id", "", "uniq-id of the Cloud Server")
-	if err := cloudNetworkPublicListCmd.MarkFlagRequired("uniq-id"); err != nil {
-		lwCliInst.Die(err)
-	}
+	addTargetFlags(cloudNetworkPublicListCmd, "uniq-id of the Cloud Server")
 }
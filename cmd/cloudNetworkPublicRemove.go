@@ -42,7 +42,7 @@ Note that you cannot remove the Cloud Servers primary ip with this command.`,
 	Run: func(cmd *cobra.Command, args []string) {
 		params := &instance.CloudNetworkPublicRemoveParams{}
 
-		params.UniqId, _ = cmd.Flags().GetString("uniq-id")
+		params.UniqId = singleTarget(cmd)
 		params.ConfigureIps, _ = cmd.Flags().GetBool("configure-ips")
 		params.Ips = cloudNetworkPublicRemoveCmdIpsFlag
 
@@ -57,15 +57,12 @@ Note that you cannot remove the Cloud Servers primary ip with this command.`,
 
 func init() {
 	cloudNetworkPublicCmd.AddCommand(cloudNetworkPublicRemoveCmd)
-	cloudNetworkPublicRemoveCmd.Flags().String("uniq-id", "", "uniq-id of the Cloud Server")
+	addSingleTargetFlags(cloudNetworkPublicRemoveCmd, "uniq-id of the Cloud Server")
 	cloudNetworkPublicRemoveCmd.Flags().Bool("configure-ips", false,
 		"whether or not to automatically remove the IP address(es) in the server config")
 	cloudNetworkPublicRemoveCmd.Flags().StringSliceVar(&cloudNetworkPublicRemoveCmdIpsFlag, "ips", []string{},
 		"ips separated by ',' to remove from the Cloud Server")
 
-	if err := cloudNetworkPublicRemoveCmd.MarkFlagRequired("uniq-id"); err != nil {
-		lwCliInst.Die(err)
-	}
 	if err := cloudNetworkPublicRemoveCmd.MarkFlagRequired("ips"); err != nil {
 		lwCliInst.Die(err)
 	}
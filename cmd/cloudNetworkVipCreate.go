@@ -18,10 +18,10 @@ package cmd
 import (
 	"fmt"
 
-	"github.com/spf13/cast"
 	"github.com/spf13/cobra"
 
-	"github.com/liquidweb/liquidweb-cli/types/api"
+	lwClient "github.com/liquidweb/liquidweb-cli/client"
+	"github.com/liquidweb/liquidweb-cli/output"
 	"github.com/liquidweb/liquidweb-cli/utils"
 	"github.com/liquidweb/liquidweb-cli/validate"
 )
@@ -74,17 +74,19 @@ Heartbeat
 			lwCliInst.Die(err)
 		}
 
-		apiArgs := map[string]interface{}{
-			"domain": nameFlag,
-			"zone":   zoneFlag,
-		}
-
-		var details apiTypes.CloudNetworkVipDetails
-		err := lwCliInst.CallLwApiInto("bleed/vip/create", apiArgs, &details)
+		details, err := lwCliInst.Api.Vip.Create(lwCliInst.Context(), &lwClient.VipCreateRequest{
+			Domain: nameFlag,
+			Zone:   zoneFlag,
+		})
 		if err != nil {
 			lwCliInst.Die(err)
 		}
 
+		if cmdOutputFormat(cmd) != output.Text {
+			printResult(cmd, details)
+			return
+		}
+
 		fmt.Printf("Created VIP [%s] with uniq-id [%s]\n", details.Domain, details.UniqId)
 		fmt.Printf("\nsee 'cloud network vip details --uniq-id %s' for further details\n", details.UniqId)
 	},
@@ -94,6 +96,6 @@ func init() {
 	cloudNetworkVipCmd.AddCommand(cloudNetworkVipCreateCmd)
 	cloudNetworkVipCreateCmd.Flags().String("name", fmt.Sprintf("vip-%s", utils.RandomString(8)),
 		"name for the new VIP")
-	cloudNetworkVipCreateCmd.Flags().Int64("zone", cast.ToInt64(defaultFlag("cloud_network_vip_create_zone", -1)),
+	cloudNetworkVipCreateCmd.Flags().Int64("zone", -1,
 		"zone id to create VIP in (see: 'cloud server options --zones')")
 }
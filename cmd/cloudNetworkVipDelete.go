@@ -20,7 +20,6 @@ import (
 
 	"github.com/spf13/cobra"
 
-	"github.com/liquidweb/liquidweb-cli/types/api"
 	"github.com/liquidweb/liquidweb-cli/validate"
 )
 
@@ -61,34 +60,27 @@ Pacemaker
 Heartbeat
 `,
 	Run: func(cmd *cobra.Command, args []string) {
-		uniqIdFlag, _ := cmd.Flags().GetString("uniq-id")
-
-		validateFields := map[interface{}]interface{}{
-			uniqIdFlag: "UniqId",
-		}
-		if err := validate.Validate(validateFields); err != nil {
-			lwCliInst.Die(err)
-		}
-
-		apiArgs := map[string]interface{}{
-			"uniq_id": uniqIdFlag,
-		}
-
-		var details apiTypes.CloudNetworkVipDestroyResponse
-		err := lwCliInst.CallLwApiInto("bleed/vip/destroy", apiArgs, &details)
-		if err != nil {
-			lwCliInst.Die(err)
-		}
-
-		fmt.Printf("Deleted VIP %s\n", details.Destroyed)
+		runTargets(requireTargets(cmd), func(uniqId string) error {
+			validateFields := map[interface{}]interface{}{
+				uniqId: "UniqId",
+			}
+			if err := validate.Validate(validateFields); err != nil {
+				return err
+			}
+
+			details, err := lwCliInst.Api.Vip.Destroy(lwCliInst.Context(), uniqId)
+			if err != nil {
+				return err
+			}
+
+			fmt.Printf("Deleted VIP %s\n", details.Destroyed)
+
+			return nil
+		})
 	},
 }
 
 func init() {
 	cloudNetworkVipCmd.AddCommand(cloudNetworkVipDeleteCmd)
-	cloudNetworkVipDeleteCmd.Flags().String("uniq-id", "", "uniq-id of VIP to delete")
-
-	if err := cloudNetworkVipDeleteCmd.MarkFlagRequired("uniq-id"); err != nil {
-		lwCliInst.Die(err)
-	}
+	addTargetFlags(cloudNetworkVipDeleteCmd, "uniq-id of VIP to delete")
 }
@@ -16,11 +16,8 @@ limitations under the License.
 package cmd
 
 import (
-	"fmt"
-
 	"github.com/spf13/cobra"
 
-	"github.com/liquidweb/liquidweb-cli/types/api"
 	"github.com/liquidweb/liquidweb-cli/validate"
 )
 
@@ -61,34 +58,27 @@ Pacemaker
 Heartbeat
 `,
 	Run: func(cmd *cobra.Command, args []string) {
-		uniqIdFlag, _ := cmd.Flags().GetString("uniq-id")
-
-		validateFields := map[interface{}]interface{}{
-			uniqIdFlag: "UniqId",
-		}
-		if err := validate.Validate(validateFields); err != nil {
-			lwCliInst.Die(err)
-		}
-
-		apiArgs := map[string]interface{}{
-			"uniq_id": uniqIdFlag,
-		}
-
-		var details apiTypes.CloudNetworkVipDetails
-		err := lwCliInst.CallLwApiInto("bleed/vip/details", apiArgs, &details)
-		if err != nil {
-			lwCliInst.Die(err)
-		}
-
-		fmt.Print(details)
+		runTargets(requireTargets(cmd), func(uniqId string) error {
+			validateFields := map[interface{}]interface{}{
+				uniqId: "UniqId",
+			}
+			if err := validate.Validate(validateFields); err != nil {
+				return err
+			}
+
+			details, err := lwCliInst.Api.Vip.Details(lwCliInst.Context(), uniqId)
+			if err != nil {
+				return err
+			}
+
+			printResult(cmd, details)
+
+			return nil
+		})
 	},
 }
 
 func init() {
 	cloudNetworkVipCmd.AddCommand(cloudNetworkVipDetailsCmd)
-	cloudNetworkVipDetailsCmd.Flags().String("uniq-id", "", "uniq-id of VIP")
-
-	if err := cloudNetworkVipDetailsCmd.MarkFlagRequired("uniq-id"); err != nil {
-		lwCliInst.Die(err)
-	}
+	addTargetFlags(cloudNetworkVipDetailsCmd, "uniq-id of VIP")
 }
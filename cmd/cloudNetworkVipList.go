@@ -17,11 +17,11 @@ package cmd
 
 import (
 	"fmt"
-	"os"
 
 	"github.com/spf13/cobra"
 
-	"github.com/liquidweb/liquidweb-cli/instance"
+	lwClient "github.com/liquidweb/liquidweb-cli/client"
+	"github.com/liquidweb/liquidweb-cli/output"
 	"github.com/liquidweb/liquidweb-cli/types/api"
 )
 
@@ -30,33 +30,35 @@ var cloudNetworkVipListCmd = &cobra.Command{
 	Short: "List all VIPs on your account",
 	Long:  `List all VIPs on your account`,
 	Run: func(cmd *cobra.Command, args []string) {
-		jsonFlag, _ := cmd.Flags().GetBool("json")
-		methodArgs := instance.AllPaginatedResultsArgs{
-			Method: "bleed/asset/list",
-			MethodArgs: map[string]interface{}{
-				"type":     "SS.VIP",
-				"alsowith": []string{"zone"},
-			},
-			ResultsPerPage: 100,
-		}
-		results, err := lwCliInst.AllPaginatedResults(&methodArgs)
-		if err != nil {
-			lwCliInst.Die(err)
+		listQuery := newListQuery(cmd)
+		listOpts := lwClient.ListOptions{
+			PageSize: 100,
+			Limit:    listQuery.ListLimit(),
 		}
 
-		if jsonFlag {
-			pretty, err := lwCliInst.JsonEncodeAndPrettyPrint(results)
+		printer := newListPrinter(cmd)
+		if printer.Format.Encoded() {
+			results, err := lwCliInst.Api.Vip.All(lwCliInst.Context(), listOpts)
 			if err != nil {
 				lwCliInst.Die(err)
 			}
-			fmt.Printf(pretty)
-			os.Exit(0)
+			if results.Items, err = listQuery.Items(results.Items); err != nil {
+				lwCliInst.Die(err)
+			}
+			if err := printer.Print(results); err != nil {
+				lwCliInst.Die(err)
+			}
+			return
 		}
 
-		for _, item := range results.Items {
-			var details apiTypes.CloudNetworkVipAssetListAlsoWithZoneResponse
-			if err := instance.CastFieldTypes(item, &details); err != nil {
-				lwCliInst.Die(err)
+		err := listQuery.Run(func(visit output.ItemFunc) error {
+			return lwCliInst.Api.Vip.Each(lwCliInst.Context(), listOpts, func(details *apiTypes.CloudNetworkVipAssetListAlsoWithZoneResponse) (bool, error) {
+				return visit(details)
+			})
+		}, func(item interface{}) (bool, error) {
+			details := item.(*apiTypes.CloudNetworkVipAssetListAlsoWithZoneResponse)
+			if printer.Format != output.Text {
+				return true, printer.Add(details)
 			}
 
 			fmt.Printf("VIP Details:\n")
@@ -66,6 +68,14 @@ var cloudNetworkVipListCmd = &cobra.Command{
 			fmt.Printf("\tIP: %s\n", details.Ip)
 			fmt.Printf("\tRegion %s (id %d) Zone %s (id %d)\n", details.Zone.Region.Name,
 				details.Zone.Region.Id, details.Zone.Name, details.Zone.Id)
+
+			return true, nil
+		})
+		if err != nil {
+			lwCliInst.Die(err)
+		}
+		if err := printer.Flush(); err != nil {
+			lwCliInst.Die(err)
 		}
 	},
 }
@@ -73,5 +83,7 @@ var cloudNetworkVipListCmd = &cobra.Command{
 func init() {
 	cloudNetworkVipCmd.AddCommand(cloudNetworkVipListCmd)
 
-	cloudNetworkVipListCmd.Flags().Bool("json", false, "output in json format")
+	cloudNetworkVipListCmd.Flags().Bool("json", false, "output in json format (same as --output json)")
+	cloudNetworkVipListCmd.Flags().Int64("limit", 0, "stop after listing this many VIPs (0 for no limit)")
+	addListQueryFlags(cloudNetworkVipListCmd)
 }
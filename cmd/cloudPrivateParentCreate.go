@@ -18,10 +18,10 @@ package cmd
 import (
 	"fmt"
 
-	"github.com/spf13/cast"
 	"github.com/spf13/cobra"
 
-	"github.com/liquidweb/liquidweb-cli/types/api"
+	lwClient "github.com/liquidweb/liquidweb-cli/client"
+	"github.com/liquidweb/liquidweb-cli/output"
 	"github.com/liquidweb/liquidweb-cli/validate"
 )
 
@@ -50,16 +50,19 @@ of configs, check 'cloud server options --configs'.`,
 			lwCliInst.Die(err)
 		}
 
-		apiArgs := map[string]interface{}{
-			"domain":    nameFlag,
-			"config_id": configIdFlag,
-			"zone":      zoneFlag,
+		details, err := lwCliInst.Api.Storm.PrivateParent.Create(lwCliInst.Context(),
+			&lwClient.StormPrivateParentCreateRequest{
+				Domain:   nameFlag,
+				ConfigId: configIdFlag,
+				Zone:     zoneFlag,
+			})
+		if err != nil {
+			lwCliInst.Die(err)
 		}
 
-		var details apiTypes.CloudPrivateParentDetails
-		if err := lwCliInst.CallLwApiInto("bleed/storm/private/parent/create",
-			apiArgs, &details); err != nil {
-			lwCliInst.Die(err)
+		if cmdOutputFormat(cmd) != output.Text {
+			printResult(cmd, details)
+			return
 		}
 
 		fmt.Printf("Private Parent with name [%s] uniq-id [%s] created!\n", details.Domain, details.UniqId)
@@ -70,9 +73,9 @@ of configs, check 'cloud server options --configs'.`,
 func init() {
 	cloudPrivateParentCmd.AddCommand(cloudPrivateParentCreateCmd)
 
-	cloudPrivateParentCreateCmd.Flags().Int64("config-id", cast.ToInt64(defaultFlag("cloud_private-parent_create_config-id", -1)), "config-id (category must be bare-metal or bare-metal-r)")
+	cloudPrivateParentCreateCmd.Flags().Int64("config-id", -1, "config-id (category must be bare-metal or bare-metal-r)")
 	cloudPrivateParentCreateCmd.Flags().String("name", "", "name for your Private Parent")
-	cloudPrivateParentCreateCmd.Flags().Int64("zone", cast.ToInt64(defaultFlag("cloud_private-parent_create_zone", -1)),
+	cloudPrivateParentCreateCmd.Flags().Int64("zone", -1,
 		"id number of the zone to provision the Private Parent in ('cloud server options --zones')")
 
 	reqs := []string{"name"}
@@ -20,8 +20,6 @@ import (
 	"os"
 
 	"github.com/spf13/cobra"
-
-	"github.com/liquidweb/liquidweb-cli/types/api"
 )
 
 var cloudPrivateParentDeleteCmd = &cobra.Command{
@@ -52,13 +50,8 @@ as well as how many resources each Cloud Server gets.`,
 			lwCliInst.Die(err)
 		}
 
-		apiArgs := map[string]interface{}{
-			"uniq_id": privateParentUniqId,
-		}
-
-		var details apiTypes.CloudPrivateParentDeleteResponse
-		if err := lwCliInst.CallLwApiInto("bleed/storm/private/parent/delete",
-			apiArgs, &details); err != nil {
+		details, err := lwCliInst.Api.Storm.PrivateParent.Delete(lwCliInst.Context(), privateParentUniqId)
+		if err != nil {
 			lwCliInst.Die(err)
 		}
 
@@ -16,11 +16,7 @@ limitations under the License.
 package cmd
 
 import (
-	"fmt"
-
 	"github.com/spf13/cobra"
-
-	"github.com/liquidweb/liquidweb-cli/types/api"
 )
 
 var cloudPrivateParentDetailsCmd = &cobra.Command{
@@ -42,17 +38,12 @@ as well as how many resources each Cloud Server gets.`,
 			lwCliInst.Die(err)
 		}
 
-		apiArgs := map[string]interface{}{
-			"uniq_id": privateParentUniqId,
-		}
-
-		var details apiTypes.CloudPrivateParentDetails
-		if err := lwCliInst.CallLwApiInto("bleed/storm/private/parent/details",
-			apiArgs, &details); err != nil {
+		details, err := lwCliInst.Api.Storm.PrivateParent.Details(lwCliInst.Context(), privateParentUniqId)
+		if err != nil {
 			lwCliInst.Die(err)
 		}
 
-		fmt.Print(details)
+		printResult(cmd, details)
 	},
 }
 
@@ -20,7 +20,6 @@ import (
 
 	"github.com/spf13/cobra"
 
-	"github.com/liquidweb/liquidweb-cli/types/api"
 	"github.com/liquidweb/liquidweb-cli/validate"
 )
 
@@ -34,7 +33,7 @@ will be able to provision Cloud Servers on a Private Parent. In addition, with P
 Parents you have total control of how many instances can live on the Private Parent,
 as well as how many resources each Cloud Server gets.`,
 	Run: func(cmd *cobra.Command, args []string) {
-		uniqIdFlag, _ := cmd.Flags().GetString("uniq-id")
+		uniqIdFlag := singleTarget(cmd)
 		nameFlag, _ := cmd.Flags().GetString("name")
 
 		validateFields := map[interface{}]interface{}{
@@ -45,14 +44,8 @@ as well as how many resources each Cloud Server gets.`,
 			lwCliInst.Die(err)
 		}
 
-		apiArgs := map[string]interface{}{
-			"uniq_id": uniqIdFlag,
-			"domain":  nameFlag,
-		}
-
-		var details apiTypes.CloudPrivateParentDetails
-		if err := lwCliInst.CallLwApiInto("bleed/storm/private/parent/update",
-			apiArgs, &details); err != nil {
+		details, err := lwCliInst.Api.Storm.PrivateParent.Update(lwCliInst.Context(), uniqIdFlag, nameFlag)
+		if err != nil {
 			lwCliInst.Die(err)
 		}
 
@@ -63,12 +56,9 @@ as well as how many resources each Cloud Server gets.`,
 func init() {
 	cloudPrivateParentCmd.AddCommand(cloudPrivateParentRenameCmd)
 
-	cloudPrivateParentRenameCmd.Flags().String("uniq-id", "", "uniq-id of the Private Parent")
+	addSingleTargetFlags(cloudPrivateParentRenameCmd, "uniq-id of the Private Parent")
 	cloudPrivateParentRenameCmd.Flags().String("name", "", "name to give the Private Parent")
 
-	if err := cloudPrivateParentRenameCmd.MarkFlagRequired("uniq-id"); err != nil {
-		lwCliInst.Die(err)
-	}
 	if err := cloudPrivateParentRenameCmd.MarkFlagRequired("name"); err != nil {
 		lwCliInst.Die(err)
 	}
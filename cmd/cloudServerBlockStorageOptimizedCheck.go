@@ -20,7 +20,6 @@ import (
 
 	"github.com/spf13/cobra"
 
-	"github.com/liquidweb/liquidweb-cli/types/api"
 	"github.com/liquidweb/liquidweb-cli/validate"
 )
 
@@ -36,38 +35,31 @@ to the Cloud Server to give more to the hypervisor. We call this Cloud Block
 Storage Optimized.`,
 
 	Run: func(cmd *cobra.Command, args []string) {
-		uniqIdFlag, _ := cmd.Flags().GetString("uniq-id")
+		runTargets(requireTargets(cmd), func(uniqId string) error {
+			validateFields := map[interface{}]interface{}{
+				uniqId: "UniqId",
+			}
+			if err := validate.Validate(validateFields); err != nil {
+				return err
+			}
 
-		validateFields := map[interface{}]interface{}{
-			uniqIdFlag: "UniqId",
-		}
-		if err := validate.Validate(validateFields); err != nil {
-			lwCliInst.Die(err)
-		}
+			details, err := lwCliInst.Api.Storm.Server.IsBlockStorageOptimized(lwCliInst.Context(), uniqId)
+			if err != nil {
+				return err
+			}
 
-		apiArgs := map[string]interface{}{
-			"uniq_id": uniqIdFlag,
-		}
+			if details.IsOptimized {
+				fmt.Printf("Cloud Server [%s] is Cloud Block Storage Optimized\n", uniqId)
+			} else {
+				fmt.Printf("Cloud Server [%s] is not Cloud Block Storage Optimized\n", uniqId)
+			}
 
-		var details apiTypes.CloudServerIsBlockStorageOptimized
-		if err := lwCliInst.CallLwApiInto("bleed/storm/server/issbsoptimized",
-			apiArgs, &details); err != nil {
-			lwCliInst.Die(err)
-		}
-
-		if details.IsOptimized {
-			fmt.Println("Cloud Server is Cloud Block Storage Optimized")
-		} else {
-			fmt.Println("Cloud Server is not Cloud Block Storage Optimized")
-		}
+			return nil
+		})
 	},
 }
 
 func init() {
 	cloudServerBlockStorageOptimizedCmd.AddCommand(cloudServerBlockStorageOptimizedCheckCmd)
-	cloudServerBlockStorageOptimizedCheckCmd.Flags().String("uniq-id", "", "uniq-id of Cloud Server")
-
-	if err := cloudServerBlockStorageOptimizedCheckCmd.MarkFlagRequired("uniq-id"); err != nil {
-		lwCliInst.Die(err)
-	}
+	addTargetFlags(cloudServerBlockStorageOptimizedCheckCmd, "uniq-id of Cloud Server")
 }
@@ -20,7 +20,6 @@ import (
 
 	"github.com/spf13/cobra"
 
-	"github.com/liquidweb/liquidweb-cli/types/api"
 	"github.com/liquidweb/liquidweb-cli/validate"
 )
 
@@ -38,44 +37,35 @@ Storage Optimized.
 Enabling Cloud Block Storage Optimized will cause your Cloud Server to reboot.`,
 
 	Run: func(cmd *cobra.Command, args []string) {
-		uniqIdFlag, _ := cmd.Flags().GetString("uniq-id")
-
-		validateFields := map[interface{}]interface{}{
-			uniqIdFlag: "UniqId",
-		}
-		if err := validate.Validate(validateFields); err != nil {
-			lwCliInst.Die(err)
-		}
-
-		var optimized apiTypes.CloudServerIsBlockStorageOptimized
-		if err := lwCliInst.CallLwApiInto("bleed/storm/server/issbsoptimized",
-			map[string]interface{}{"uniq_id": uniqIdFlag}, &optimized); err != nil {
-			lwCliInst.Die(err)
-		}
-		if optimized.IsOptimized {
-			lwCliInst.Die(fmt.Errorf("Cloud Block Storage Optimized is already enabled on this Cloud Server"))
-		}
-
-		apiArgs := map[string]interface{}{
-			"uniq_id": uniqIdFlag,
-			"value":   true,
-		}
-
-		var details apiTypes.CloudServerIsBlockStorageOptimizedSetResponse
-		if err := lwCliInst.CallLwApiInto("bleed/storm/server/setsbsoptimized",
-			apiArgs, &details); err != nil {
-			lwCliInst.Die(err)
-		}
-
-		fmt.Printf("Enabled Cloud Block Storage Optimized; %+v\n", details)
+		runTargets(requireTargets(cmd), func(uniqId string) error {
+			validateFields := map[interface{}]interface{}{
+				uniqId: "UniqId",
+			}
+			if err := validate.Validate(validateFields); err != nil {
+				return err
+			}
+
+			optimized, err := lwCliInst.Api.Storm.Server.IsBlockStorageOptimized(lwCliInst.Context(), uniqId)
+			if err != nil {
+				return err
+			}
+			if optimized.IsOptimized {
+				return fmt.Errorf("Cloud Block Storage Optimized is already enabled on this Cloud Server")
+			}
+
+			details, err := lwCliInst.Api.Storm.Server.SetBlockStorageOptimized(lwCliInst.Context(), uniqId, true)
+			if err != nil {
+				return err
+			}
+
+			fmt.Printf("Enabled Cloud Block Storage Optimized; %+v\n", *details)
+
+			return nil
+		})
 	},
 }
 
 func init() {
 	cloudServerBlockStorageOptimizedCmd.AddCommand(cloudServerBlockStorageOptimizedEnableCmd)
-	cloudServerBlockStorageOptimizedEnableCmd.Flags().String("uniq-id", "", "uniq-id of Cloud Server")
-
-	if err := cloudServerBlockStorageOptimizedEnableCmd.MarkFlagRequired("uniq-id"); err != nil {
-		lwCliInst.Die(err)
-	}
+	addTargetFlags(cloudServerBlockStorageOptimizedEnableCmd, "uniq-id of Cloud Server")
 }
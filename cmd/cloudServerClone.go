@@ -18,10 +18,10 @@ package cmd
 import (
 	"fmt"
 
-	"github.com/spf13/cast"
 	"github.com/spf13/cobra"
 
-	"github.com/liquidweb/liquidweb-cli/types/api"
+	lwClient "github.com/liquidweb/liquidweb-cli/client"
+	"github.com/liquidweb/liquidweb-cli/output"
 	"github.com/liquidweb/liquidweb-cli/utils"
 	"github.com/liquidweb/liquidweb-cli/validate"
 )
@@ -49,7 +49,7 @@ or its name.
 The flags --diskspace --vcpu --memory must all be passed if the source Cloud
 Server is not on a Private Parent.`,
 	Run: func(cmd *cobra.Command, args []string) {
-		uniqIdFlag, _ := cmd.Flags().GetString("uniq-id")
+		uniqIdFlag := singleTarget(cmd)
 		passwordFlag, _ := cmd.Flags().GetString("password")
 		zoneFlag, _ := cmd.Flags().GetInt64("zone")
 		newIpsFlag, _ := cmd.Flags().GetInt64("new-ips")
@@ -87,46 +87,42 @@ Server is not on a Private Parent.`,
 		}
 
 		// buildout api bleed/server/clone parameters
-		cloneArgs := map[string]interface{}{
-			"uniq_id":  uniqIdFlag,
-			"domain":   hostnameFlag,
-			"new_ips":  newIpsFlag,
-			"new_ip6s": newIp6sFlag,
-		}
-		if passwordFlag != "" {
-			cloneArgs["password"] = passwordFlag
+		cloneReq := &lwClient.ServerCloneRequest{
+			UniqId:   uniqIdFlag,
+			Domain:   hostnameFlag,
+			NewIps:   newIpsFlag,
+			NewIp6s:  newIp6sFlag,
+			Password: passwordFlag,
+			Parent:   privateParentUniqId,
 		}
 		if zoneFlag != -1 {
-			cloneArgs["zone"] = zoneFlag
+			cloneReq.Zone = zoneFlag
 			validateFields[zoneFlag] = "PositiveInt64"
 		}
-		if privateParentUniqId != "" {
-			cloneArgs["parent"] = privateParentUniqId
-		}
 		if diskspaceFlag != -1 {
-			cloneArgs["diskspace"] = diskspaceFlag
+			cloneReq.Diskspace = diskspaceFlag
 			validateFields[diskspaceFlag] = "PositiveInt64"
 		}
 		if memoryFlag != -1 {
-			cloneArgs["memory"] = memoryFlag
+			cloneReq.Memory = memoryFlag
 			validateFields[memoryFlag] = "PositiveInt64"
 		}
 		if vcpuFlag != -1 {
-			cloneArgs["vcpu"] = vcpuFlag
+			cloneReq.Vcpu = vcpuFlag
 			validateFields[vcpuFlag] = "PositiveInt64"
 		}
 		if configIdFlag != -1 && privateParentFlag == "" {
-			cloneArgs["config_id"] = configIdFlag
+			cloneReq.ConfigId = configIdFlag
 			validateFields[configIdFlag] = "PositiveInt64"
 		}
 		if len(cloudServerCloneCmdPoolIpsFlag) > 0 {
-			cloneArgs["pool_ips"] = cloudServerCloneCmdPoolIpsFlag
+			cloneReq.PoolIps = cloudServerCloneCmdPoolIpsFlag
 			for _, ip := range cloudServerCloneCmdPoolIpsFlag {
 				validateFields[ip] = "IP"
 			}
 		}
 		if len(cloudServerCloneCmdPool6IpsFlag) > 0 {
-			cloneArgs["pool6_ips"] = cloudServerCloneCmdPool6IpsFlag
+			cloneReq.Pool6Ips = cloudServerCloneCmdPool6IpsFlag
 			for _, ip := range cloudServerCloneCmdPool6IpsFlag {
 				validateFields[ip] = "CIDR"
 			}
@@ -136,11 +132,16 @@ Server is not on a Private Parent.`,
 			lwCliInst.Die(err)
 		}
 
-		var details apiTypes.CloudServerCloneResponse
-		if err := lwCliInst.CallLwApiInto("bleed/server/clone", cloneArgs, &details); err != nil {
+		details, err := lwCliInst.Api.Server.Clone(lwCliInst.Context(), cloneReq)
+		if err != nil {
 			lwCliInst.Die(err)
 		}
 
+		if cmdOutputFormat(cmd) != output.Text {
+			printResult(cmd, details)
+			return
+		}
+
 		fmt.Printf(
 			"Success! Cloning existing Cloud Server [%s] to new Cloud Server [%s]. Check status with 'cloud server status --uniq-id %s'\n",
 			uniqIdFlag, details.UniqId, uniqIdFlag)
@@ -152,7 +153,7 @@ func init() {
 	cloudServerCmd.AddCommand(cloudServerCloneCmd)
 
 	// General
-	cloudServerCloneCmd.Flags().String("uniq-id", "", "uniq-id of Cloud Server to clone")
+	addSingleTargetFlags(cloudServerCloneCmd, "uniq-id of Cloud Server to clone")
 	cloudServerCloneCmd.Flags().String("password", "", "root or administrator password for new Cloud Server")
 	cloudServerCloneCmd.Flags().Int64("zone", -1, "zone for new Cloud Server")
 	cloudServerCloneCmd.Flags().Int64("new-ips", 1, "amount of IPv4 addresses for the new Cloud Server")
@@ -172,10 +173,6 @@ func init() {
 	cloudServerCloneCmd.Flags().Int64("vcpu", -1, "amount of vcpus for new Cloud Server (when private-parent)")
 
 	// Non Private Parent
-	cloudServerCloneCmd.Flags().Int64("config-id", cast.ToInt64(defaultFlag("cloud_server_clone_config-id", -1)),
+	cloudServerCloneCmd.Flags().Int64("config-id", -1,
 		"config-id for new Cloud Server (when !private-parent) (see: 'cloud server options --configs')")
-
-	if err := cloudServerCloneCmd.MarkFlagRequired("uniq-id"); err != nil {
-		lwCliInst.Die(err)
-	}
 }
@@ -19,16 +19,13 @@ import (
 	"fmt"
 	"os"
 
-	"github.com/spf13/cast"
 	"github.com/spf13/cobra"
 
-	"github.com/liquidweb/liquidweb-cli/instance"
+	lwClient "github.com/liquidweb/liquidweb-cli/client"
 	"github.com/liquidweb/liquidweb-cli/types/api"
 	"github.com/liquidweb/liquidweb-cli/utils"
 )
 
-var cloudServerDestroyCmdUniqIdFlag []string
-
 var cloudServerDestroyCmd = &cobra.Command{
 	Use:   "destroy",
 	Short: "Destroy a Cloud Server",
@@ -41,36 +38,28 @@ server.`,
 		commentFlag, _ := cmd.Flags().GetString("comment")
 		reasonFlag, _ := cmd.Flags().GetString("reason")
 		forceFlag, _ := cmd.Flags().GetBool("force")
+		targets := requireTargets(cmd)
 
 		destroyTargets := map[string]interface{}{}
 
 		if forceFlag {
-			for _, uniqId := range cloudServerDestroyCmdUniqIdFlag {
+			for _, uniqId := range targets {
 				destroyTargets[uniqId] = "" // didnt do lookup, so dont know hostname
 			}
 		} else {
-			methodArgs := instance.AllPaginatedResultsArgs{
-				Method:         "bleed/storm/server/list",
-				ResultsPerPage: 100,
-			}
-			results, err := lwCliInst.AllPaginatedResults(&methodArgs)
-			if err != nil {
-				lwCliInst.Die(err)
-			}
-
-			for _, item := range results.Items {
-				uniqId := cast.ToString(item["uniq_id"])
-				var shouldDestroy bool
-				for _, candidateUniqId := range cloudServerDestroyCmdUniqIdFlag {
-					if uniqId == candidateUniqId {
-						shouldDestroy = true
-						break
+			err := lwCliInst.Api.Storm.Server.Each(lwCliInst.Context(), lwClient.ListOptions{PageSize: 100},
+				func(cs *apiTypes.CloudServerDetails) (bool, error) {
+					for _, candidateUniqId := range targets {
+						if cs.UniqId == candidateUniqId {
+							destroyTargets[cs.UniqId] = cs.Domain
+							break
+						}
 					}
-				}
 
-				if shouldDestroy {
-					destroyTargets[uniqId] = cast.ToString(item["domain"])
-				}
+					return true, nil
+				})
+			if err != nil {
+				lwCliInst.Die(err)
 			}
 
 			if len(destroyTargets) == 0 {
@@ -89,43 +78,41 @@ server.`,
 			}
 		}
 
-		for uniqId, hostname := range destroyTargets {
-			destroyArgs := map[string]interface{}{
-				"uniq_id":              uniqId,
-				"cancellation_comment": commentFlag,
-			}
-
-			if reasonFlag != "" {
-				destroyArgs["cancellation_reason"] = reasonFlag
+		var destroyUniqIds []string
+		for _, uniqId := range targets {
+			if _, found := destroyTargets[uniqId]; found {
+				destroyUniqIds = append(destroyUniqIds, uniqId)
 			}
+		}
 
-			var destroyed apiTypes.CloudServerDestroyResponse
-			err := lwCliInst.CallLwApiInto("bleed/server/destroy", destroyArgs, &destroyed)
+		runTargets(destroyUniqIds, func(uniqId string) error {
+			destroyed, err := lwCliInst.Api.Server.Destroy(lwCliInst.Context(), &lwClient.ServerDestroyRequest{
+				UniqId:              uniqId,
+				CancellationComment: commentFlag,
+				CancellationReason:  reasonFlag,
+			})
 			if err != nil {
-				lwCliInst.Die(err)
+				return err
 			}
 
-			if hostname == "" {
+			if hostname := destroyTargets[uniqId]; hostname == "" {
 				fmt.Printf("destroyed: %s\n", destroyed.Destroyed)
 			} else {
 				fmt.Printf("destroyed: %s (%s)\n", destroyed.Destroyed, hostname)
 			}
-		}
+
+			return nil
+		})
 	},
 }
 
 func init() {
 	cloudServerCmd.AddCommand(cloudServerDestroyCmd)
 
-	cloudServerDestroyCmd.Flags().StringSliceVar(&cloudServerDestroyCmdUniqIdFlag, "uniq-id",
-		[]string{}, "uniq-ids separated by ',' of server(s) to destroy")
+	addTargetFlags(cloudServerDestroyCmd, "uniq-id(s) of server(s) to destroy")
 	cloudServerDestroyCmd.Flags().String("comment", "initiated from liquidweb-cli",
 		"comment related to the cancellation")
 	cloudServerDestroyCmd.Flags().String("reason", "",
 		"reason for the cancellation (optional)")
 	cloudServerDestroyCmd.Flags().Bool("force", false, "bypass dialog confirmation")
-
-	if err := cloudServerDestroyCmd.MarkFlagRequired("uniq-id"); err != nil {
-		lwCliInst.Die(err)
-	}
 }
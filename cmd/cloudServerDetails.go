@@ -20,14 +20,14 @@ import (
 
 	"github.com/spf13/cobra"
 
-	"github.com/liquidweb/liquidweb-cli/instance"
+	lwClient "github.com/liquidweb/liquidweb-cli/client"
+	"github.com/liquidweb/liquidweb-cli/output"
 	"github.com/liquidweb/liquidweb-cli/types/api"
 	"github.com/liquidweb/liquidweb-cli/validate"
 )
 
-var blockStorageVolumeList apiTypes.MergedPaginatedList
+var blockStorageVolumeList []*apiTypes.CloudBlockStorageVolumeDetails
 var fetchedBlockStorageVolumes bool
-var cloudServerDetailsCmdUniqIdFlag []string
 
 var cloudServerDetailsCmd = &cobra.Command{
 	Use:   "details",
@@ -39,33 +39,27 @@ You can check this methods API documentation for what the returned fields mean:
 https://cart.liquidweb.com/storm/api/docs/bleed/Storm/Server.html#method_details
 `,
 	Run: func(cmd *cobra.Command, args []string) {
-		jsonFlag, _ := cmd.Flags().GetBool("json")
-
-		for _, uniqId := range cloudServerDetailsCmdUniqIdFlag {
+		printer := newPrinter(cmd)
+		runPrintedTargets(printer, requireTargets(cmd), func(uniqId string) error {
 			validateFields := map[interface{}]interface{}{
 				uniqId: "UniqId",
 			}
 			if err := validate.Validate(validateFields); err != nil {
-				fmt.Printf("%s ... skipping\n", err)
-				continue
+				return err
 			}
 
-			var details apiTypes.CloudServerDetails
-			if err := lwCliInst.CallLwApiInto("bleed/storm/server/details",
-				map[string]interface{}{"uniq_id": uniqId}, &details); err != nil {
-				lwCliInst.Die(err)
+			details, err := lwCliInst.Api.Storm.Server.Details(lwCliInst.Context(), uniqId)
+			if err != nil {
+				return err
 			}
 
-			if jsonFlag {
-				pretty, err := lwCliInst.JsonEncodeAndPrettyPrint(details)
-				if err != nil {
-					lwCliInst.Die(err)
-				}
-				fmt.Print(pretty)
-			} else {
-				_printExtendedCloudServerDetails(&details)
+			if printer.Format != output.Text {
+				return printer.Print(details)
 			}
-		}
+			_printExtendedCloudServerDetails(details)
+
+			return nil
+		})
 	},
 }
 
@@ -73,9 +67,7 @@ func _printExtendedCloudServerDetails(details *apiTypes.CloudServerDetails) {
 	fmt.Print(details)
 
 	// private network
-	var attachedDetails apiTypes.CloudNetworkPrivateIsAttachedResponse
-	err := lwCliInst.CallLwApiInto("bleed/network/private/isattached", map[string]interface{}{
-		"uniq_id": details.UniqId}, &attachedDetails)
+	attachedDetails, err := lwCliInst.Api.Network.Private.IsAttached(lwCliInst.Context(), details.UniqId)
 	if err != nil {
 		lwCliInst.Die(err)
 	}
@@ -88,24 +80,18 @@ func _printExtendedCloudServerDetails(details *apiTypes.CloudServerDetails) {
 
 	// block storage
 	if !fetchedBlockStorageVolumes {
-		methodArgs := instance.AllPaginatedResultsArgs{
-			Method:         "bleed/storage/block/volume/list",
-			ResultsPerPage: 100,
-		}
-
-		blockStorageVolumeList, err = lwCliInst.AllPaginatedResults(&methodArgs)
+		err = lwCliInst.Api.Storage.Block.Volume.Each(lwCliInst.Context(), lwClient.ListOptions{PageSize: 100},
+			func(volume *apiTypes.CloudBlockStorageVolumeDetails) (bool, error) {
+				blockStorageVolumeList = append(blockStorageVolumeList, volume)
+				return true, nil
+			})
 		if err != nil {
 			lwCliInst.Die(err)
 		}
 		fetchedBlockStorageVolumes = true
 	}
 	fmt.Printf("\tBlock Storage Volumes:\n")
-	for _, item := range blockStorageVolumeList.Items {
-		var blockStorageDetails apiTypes.CloudBlockStorageVolumeDetails
-		if err := instance.CastFieldTypes(item, &blockStorageDetails); err != nil {
-			lwCliInst.Die(err)
-		}
-
+	for _, blockStorageDetails := range blockStorageVolumeList {
 		for _, entry := range blockStorageDetails.AttachedTo {
 			if entry.Resource == details.UniqId {
 				fmt.Printf("\t\tVolume: %s\n", blockStorageDetails.Domain)
@@ -122,11 +108,6 @@ func _printExtendedCloudServerDetails(details *apiTypes.CloudServerDetails) {
 func init() {
 	cloudServerCmd.AddCommand(cloudServerDetailsCmd)
 
-	cloudServerDetailsCmd.Flags().Bool("json", false, "output in json format")
-	cloudServerDetailsCmd.Flags().StringSliceVar(&cloudServerDetailsCmdUniqIdFlag, "uniq-id", []string{},
-		"uniq-id of the cloud server. For multiple, must be ',' separated")
-
-	if err := cloudServerDetailsCmd.MarkFlagRequired("uniq-id"); err != nil {
-		lwCliInst.Die(err)
-	}
+	cloudServerDetailsCmd.Flags().Bool("json", false, "output in json format (same as --output json)")
+	addTargetFlags(cloudServerDetailsCmd, "uniq-id of the cloud server")
 }
@@ -20,7 +20,8 @@ import (
 
 	"github.com/spf13/cobra"
 
-	"github.com/liquidweb/liquidweb-cli/instance"
+	lwClient "github.com/liquidweb/liquidweb-cli/client"
+	"github.com/liquidweb/liquidweb-cli/output"
 	"github.com/liquidweb/liquidweb-cli/types/api"
 )
 
@@ -29,43 +30,54 @@ var cloudServerListCmd = &cobra.Command{
 	Short: "List Cloud Servers on your account",
 	Long:  `List Cloud Servers on your account`,
 	Run: func(cmd *cobra.Command, args []string) {
-		jsonFlag, _ := cmd.Flags().GetBool("json")
 		zoneFlag, _ := cmd.Flags().GetInt64("zone")
 
-		methodArgs := instance.AllPaginatedResultsArgs{
-			Method:         "bleed/storm/server/list",
-			ResultsPerPage: 100,
-		}
-		results, err := lwCliInst.AllPaginatedResults(&methodArgs)
-		if err != nil {
-			lwCliInst.Die(err)
-		}
+		listQuery := newListQuery(cmd)
+		listOpts := lwClient.ListOptions{PageSize: 100}
 
-		if jsonFlag {
-			pretty, err := lwCliInst.JsonEncodeAndPrettyPrint(results)
+		printer := newListPrinter(cmd)
+		if printer.Format.Encoded() {
+			listOpts.Limit = listQuery.ListLimit()
+			results, err := lwCliInst.Api.Storm.Server.All(lwCliInst.Context(), listOpts)
 			if err != nil {
 				lwCliInst.Die(err)
 			}
-			fmt.Printf(pretty)
-		} else {
-			serverCnt := 1
-			for _, item := range results.Items {
-
-				var details apiTypes.CloudServerDetails
-				if err := instance.CastFieldTypes(item, &details); err != nil {
-					lwCliInst.Die(err)
-				}
+			if results.Items, err = listQuery.Items(results.Items); err != nil {
+				lwCliInst.Die(err)
+			}
+			if err := printer.Print(results); err != nil {
+				lwCliInst.Die(err)
+			}
+			return
+		}
 
-				if zoneFlag != -1 {
-					if details.Zone.Id != zoneFlag {
-						continue
-					}
+		// zone filtering happens client side, so --limit is left to listQuery, which
+		// applies it to printed servers, rather than handed to the paginator.
+		var serverCnt int64
+		err := listQuery.Run(func(visit output.ItemFunc) error {
+			return lwCliInst.Api.Storm.Server.Each(lwCliInst.Context(), listOpts, func(details *apiTypes.CloudServerDetails) (bool, error) {
+				if zoneFlag != -1 && details.Zone.Id != zoneFlag {
+					return true, nil
 				}
+				return visit(details)
+			})
+		}, func(item interface{}) (bool, error) {
+			details := item.(*apiTypes.CloudServerDetails)
 
-				fmt.Printf("%d.) ", serverCnt)
-				_printExtendedCloudServerDetails(&details)
-				serverCnt++
+			serverCnt++
+			if printer.Format != output.Text {
+				return true, printer.Add(details)
 			}
+			fmt.Printf("%d.) ", serverCnt)
+			_printExtendedCloudServerDetails(details)
+
+			return true, nil
+		})
+		if err != nil {
+			lwCliInst.Die(err)
+		}
+		if err := printer.Flush(); err != nil {
+			lwCliInst.Die(err)
 		}
 	},
 }
@@ -74,5 +86,7 @@ func init() {
 	cloudServerCmd.AddCommand(cloudServerListCmd)
 
 	cloudServerListCmd.Flags().Int64("zone", -1, "list only in this zone")
-	cloudServerListCmd.Flags().Bool("json", false, "output in json format")
+	cloudServerListCmd.Flags().Bool("json", false, "output in json format (same as --output json)")
+	cloudServerListCmd.Flags().Int64("limit", 0, "stop after listing this many Cloud Servers (0 for no limit)")
+	addListQueryFlags(cloudServerListCmd)
 }
@@ -23,7 +23,9 @@ import (
 	"github.com/spf13/cast"
 	"github.com/spf13/cobra"
 
-	"github.com/liquidweb/liquidweb-cli/instance"
+	lwClient "github.com/liquidweb/liquidweb-cli/client"
+	"github.com/liquidweb/liquidweb-cli/output"
+	"github.com/liquidweb/liquidweb-cli/types/api"
 )
 
 var cloudServerOptionsCmd = &cobra.Command{
@@ -47,9 +49,9 @@ Be sure to take a look at the flags section for specific flags to pass.`,
 		configCategoryFlag, _ := cmd.Flags().GetString("config-category")
 		zonesFlag, _ := cmd.Flags().GetBool("zones")
 		templatesFlag, _ := cmd.Flags().GetBool("templates")
-		jsonFlag, _ := cmd.Flags().GetBool("json")
+		printer := newListPrinter(cmd)
 
-		if !configsFlag && !zonesFlag && !templatesFlag && !jsonFlag {
+		if !configsFlag && !zonesFlag && !templatesFlag && printer.Format == output.Text {
 			if err := cmd.Help(); err != nil {
 				lwCliInst.Die(err)
 			}
@@ -59,128 +61,153 @@ Be sure to take a look at the flags section for specific flags to pass.`,
 		// buildout regionsWithZoneInfo before proceeding
 		regionsWithZoneInfo := map[int][]map[string]interface{}{}
 
-		cfgListArgs := instance.AllPaginatedResultsArgs{
-			Method: "bleed/storm/config/list",
-			MethodArgs: map[string]interface{}{
-				"category":  configCategoryFlag,
-				"available": 1,
-			},
-			ResultsPerPage: 100,
-		}
-		mergedConfigs, err := lwCliInst.AllPaginatedResults(&cfgListArgs)
-		if err != nil {
-			lwCliInst.Die(err)
-		}
-
-		mergedTemplates, err := lwCliInst.AllPaginatedResults(&instance.AllPaginatedResultsArgs{
-			Method:         "bleed/storm/template/list",
-			ResultsPerPage: 100,
-		})
-		if err != nil {
-			lwCliInst.Die(err)
+		configListReq := &lwClient.StormConfigListRequest{
+			ListOptions: lwClient.ListOptions{PageSize: 100},
+			Category:    configCategoryFlag,
+			Available:   1,
 		}
 
 		// add config and zone data to regionsWithZoneInfo
 		zones := map[int]int{}
 		configIdsByZone := map[int][]map[string]interface{}{}
-		for _, item := range mergedConfigs.Items {
-			if _, zoneAvailExists := item["zone_availability"]; !zoneAvailExists {
-				continue
-			}
-			for key, value := range item["zone_availability"].(map[string]interface{}) {
-				if cast.ToInt(value) == 1 {
-					zone := cast.ToInt(key)
-					if _, exists := zones[zone]; !exists {
-						zones[zone] = 1
-					}
+		err := lwCliInst.Api.Storm.Config.Each(lwCliInst.Context(), configListReq,
+			func(config *apiTypes.CloudConfigDetails) (bool, error) {
+				for _, zoneAvailability := range config.ZoneAvailability {
+					for zone, available := range zoneAvailability {
+						if available != 1 {
+							continue
+						}
+						if _, exists := zones[zone]; !exists {
+							zones[zone] = 1
+						}
 
-					configDescription := cast.ToString(item["description"])
-					if strings.Contains(configDescription, "VPS - KA") {
-						continue
-					} else if strings.Contains(configDescription, "Hybrid Dedicated") {
-						continue
-					}
+						if strings.Contains(config.Description, "VPS - KA") {
+							continue
+						} else if strings.Contains(config.Description, "Hybrid Dedicated") {
+							continue
+						}
 
-					configData := map[string]interface{}{
-						"config_id":   cast.ToInt(item["id"]),
-						"active":      cast.ToInt(item["active"]),
-						"available":   cast.ToInt(item["available"]),
-						"category":    cast.ToString(item["category"]),
-						"description": configDescription,
-						"featured":    cast.ToInt(item["featured"]),
-					}
+						configData := map[string]interface{}{
+							"config_id":   int(config.Id),
+							"active":      int(config.Active),
+							"available":   int(config.Available),
+							"category":    config.Category,
+							"description": config.Description,
+							"featured":    int(config.Featured),
+						}
 
-					if configData["category"] == "bare-metal" || configData["category"] == "bare-metal-r" {
-						configData["disk_count"] = cast.ToInt(item["disk_count"])
-						configData["disk_total"] = cast.ToInt(item["disk_total"])
-						configData["disk_type"] = cast.ToString(item["disk_type"])
-						configData["ram_available"] = cast.ToInt(item["ram_available"])
-						configData["ram_total"] = cast.ToInt(item["ram_total"])
-						configData["cpu_cores"] = cast.ToInt(item["cpu_cores"])
-						configData["cpu_count"] = cast.ToInt(item["cpu_count"])
-						configData["cpu_hyperthreading"] = cast.ToInt(item["cpu_hyperthreading"])
-						configData["cpu_model"] = cast.ToString(item["cpu_model"])
-						configData["cpu_speed"] = cast.ToInt(item["cpu_speed"])
-					} else {
-						configData["disk"] = cast.ToInt(item["disk"])
-						configData["memory"] = cast.ToInt(item["memory"])
-						configData["vcpu"] = cast.ToInt(item["vcpu"])
-					}
+						if config.Category == "bare-metal" || config.Category == "bare-metal-r" {
+							configData["disk_count"] = int(config.DiskCount)
+							configData["disk_total"] = int(config.DiskTotal)
+							configData["disk_type"] = config.DiskType
+							configData["ram_available"] = int(config.RamAvailable)
+							configData["ram_total"] = int(config.RamTotal)
+							configData["cpu_cores"] = int(config.CpuCores)
+							configData["cpu_count"] = int(config.CpuCount)
+							configData["cpu_hyperthreading"] = int(config.CpuHyperthreading)
+							configData["cpu_model"] = config.CpuModel
+							configData["cpu_speed"] = int(config.CpuSpeed)
+						} else {
+							configData["disk"] = int(config.Disk)
+							configData["memory"] = int(config.Memory)
+							configData["vcpu"] = int(config.Vcpu)
+						}
 
-					configIdsByZone[zone] = append(configIdsByZone[zone], configData)
+						configIdsByZone[zone] = append(configIdsByZone[zone], configData)
+					}
 				}
-			}
+
+				return true, nil
+			})
+		if err != nil {
+			lwCliInst.Die(err)
 		}
 
 		// determine template availability by zone
 		templatesByZone := map[int][]map[string]interface{}{}
-		for _, template := range mergedTemplates.Items {
-			for templateZoneStr, _ := range template["zone_availability"].(map[string]interface{}) {
-				if cast.ToInt(template["deprecated"]) != 0 {
-					continue
+		err = lwCliInst.Api.Storm.Template.Each(lwCliInst.Context(), lwClient.ListOptions{PageSize: 100},
+			func(template *apiTypes.CloudTemplateDetails) (bool, error) {
+				if template.Deprecated != 0 {
+					return true, nil
 				}
 
-				templateZone := cast.ToInt(templateZoneStr)
-				templateData := map[string]interface{}{
-					"name":         template["name"],
-					"os":           template["os"],
-					"id":           cast.ToInt(template["id"]),
-					"manage_level": template["manage_level"],
-					"description":  template["description"],
+				for templateZoneStr := range template.ZoneAvailability {
+					templateZone := cast.ToInt(templateZoneStr)
+					templateData := map[string]interface{}{
+						"name":         template.Name,
+						"os":           template.Os,
+						"id":           int(template.Id),
+						"manage_level": template.ManageLevel,
+						"description":  template.Description,
+					}
+					templatesByZone[templateZone] = append(templatesByZone[templateZone], templateData)
 				}
-				templatesByZone[templateZone] = append(templatesByZone[templateZone], templateData)
-			}
+
+				return true, nil
+			})
+		if err != nil {
+			lwCliInst.Die(err)
 		}
 
 		// add final region, config, and template data to regionsWithZoneInfo
-		for zone, _ := range zones {
-			zoneDetails, err := lwCliInst.LwCliApiClient.Call("bleed/network/zone/details", map[string]interface{}{"id": zone})
+		for zone := range zones {
+			zoneDetails, err := lwCliInst.Api.Network.Zone.Details(lwCliInst.Context(), int64(zone))
 			if err != nil {
 				lwCliInst.Die(err)
 			}
 
-			if zoneDetails.(map[string]interface{})["status"] != "Open" {
+			if zoneDetails.Status != "Open" {
 				continue
 			}
 
-			regionId := cast.ToInt(zoneDetails.(map[string]interface{})["region"].(map[string]interface{})["id"])
+			regionId := int(zoneDetails.Region.Id)
 			regionsWithZoneInfo[regionId] = append(regionsWithZoneInfo[regionId], map[string]interface{}{
-				"regionName": zoneDetails.(map[string]interface{})["region"].(map[string]interface{})["name"],
+				"regionName": zoneDetails.Region.Name,
 				"regionId":   regionId,
 				"zoneId":     zone,
-				"zoneName":   zoneDetails.(map[string]interface{})["name"],
+				"zoneName":   zoneDetails.Name,
 				"configIds":  configIdsByZone[zone],
 				"templates":  templatesByZone[zone],
 			})
 		}
 
-		if jsonFlag {
-			pretty, err := lwCliInst.JsonEncodeAndPrettyPrint(regionsWithZoneInfo)
-			if err != nil {
+		if printer.Format.Encoded() || printer.Format == output.Template {
+			if err := printer.Print(regionsWithZoneInfo); err != nil {
+				lwCliInst.Die(err)
+			}
+			return
+		}
+
+		if printer.Format.Tabular() {
+			// a row per zone, with what can be created in it
+			for region, dataSlice := range regionsWithZoneInfo {
+				for _, info := range dataSlice {
+					var configIds []int
+					for _, cfgInfo := range info["configIds"].([]map[string]interface{}) {
+						configIds = append(configIds, cfgInfo["config_id"].(int))
+					}
+					var templateNames []string
+					for _, templateInfo := range info["templates"].([]map[string]interface{}) {
+						templateNames = append(templateNames, templateInfo["name"].(string))
+					}
+
+					err := printer.Add(map[string]interface{}{
+						"region_id":   region,
+						"region_name": info["regionName"],
+						"zone_id":     info["zoneId"],
+						"zone_name":   info["zoneName"],
+						"config_ids":  configIds,
+						"templates":   templateNames,
+					})
+					if err != nil {
+						lwCliInst.Die(err)
+					}
+				}
+			}
+			if err := printer.Flush(); err != nil {
 				lwCliInst.Die(err)
 			}
-			fmt.Printf(pretty)
-			os.Exit(0)
+			return
 		}
 
 		for region, dataSlice := range regionsWithZoneInfo {
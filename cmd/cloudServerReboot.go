@@ -23,8 +23,6 @@ import (
 	"github.com/liquidweb/liquidweb-cli/instance"
 )
 
-var cloudServerRebootCmdUniqIdFlag []string
-
 var cloudServerRebootCmd = &cobra.Command{
 	Use:   "reboot",
 	Short: "Reboot a Cloud Server",
@@ -35,16 +33,18 @@ To perform a forced a reboot, you must use --force`,
 		params := &instance.CloudServerRebootParams{}
 		params.Force, _ = cmd.Flags().GetBool("force")
 
-		for _, uniqId := range cloudServerRebootCmdUniqIdFlag {
+		runTargets(requireTargets(cmd), func(uniqId string) error {
 			params.UniqId = uniqId
 
 			status, err := lwCliInst.CloudServerReboot(params)
 			if err != nil {
-				lwCliInst.Die(err)
+				return err
 			}
 
 			fmt.Print(status)
-		}
+
+			return nil
+		})
 	},
 }
 
@@ -52,10 +52,5 @@ func init() {
 	cloudServerCmd.AddCommand(cloudServerRebootCmd)
 
 	cloudServerRebootCmd.Flags().Bool("force", false, "perform a forced reboot")
-	cloudServerRebootCmd.Flags().StringSliceVar(&cloudServerRebootCmdUniqIdFlag, "uniq-id", []string{},
-		"uniq-id(s) to get status of. For multiple, must be ',' separated")
-
-	if err := cloudServerRebootCmd.MarkFlagRequired("uniq-id"); err != nil {
-		lwCliInst.Die(err)
-	}
+	addTargetFlags(cloudServerRebootCmd, "uniq-id(s) to reboot")
 }
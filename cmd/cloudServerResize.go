@@ -18,7 +18,6 @@ package cmd
 import (
 	"fmt"
 
-	"github.com/spf13/cast"
 	"github.com/spf13/cobra"
 
 	"github.com/liquidweb/liquidweb-cli/instance"
@@ -78,7 +77,6 @@ lw plan --file /tmp/cloud.server.resize.yaml --var uniq_id=ABC123
 	Run: func(cmd *cobra.Command, args []string) {
 		params := &instance.CloudServerResizeParams{}
 
-		params.UniqId, _ = cmd.Flags().GetString("uniq-id")
 		params.DiskSpace, _ = cmd.Flags().GetInt64("diskspace")
 		params.ConfigId, _ = cmd.Flags().GetInt64("config-id")
 		params.Memory, _ = cmd.Flags().GetInt64("memory")
@@ -86,12 +84,18 @@ lw plan --file /tmp/cloud.server.resize.yaml --var uniq_id=ABC123
 		params.Vcpu, _ = cmd.Flags().GetInt64("vcpu")
 		params.PrivateParent, _ = cmd.Flags().GetString("private-parent")
 
-		status, err := lwCliInst.CloudServerResize(params)
-		if err != nil {
-			lwCliInst.Die(err)
-		}
+		runTargets(requireTargets(cmd), func(uniqId string) error {
+			params.UniqId = uniqId
 
-		fmt.Print(status)
+			status, err := lwCliInst.CloudServerResize(params)
+			if err != nil {
+				return err
+			}
+
+			fmt.Print(status)
+
+			return nil
+		})
 	},
 }
 
@@ -100,15 +104,11 @@ func init() {
 
 	cloudServerResizeCmd.Flags().String("private-parent", "",
 		"name or uniq-id of the private-parent. Must use when adding/removing resources to a Cloud Server on a private parent.")
-	cloudServerResizeCmd.Flags().String("uniq-id", "", "uniq-id of server to resize")
+	addTargetFlags(cloudServerResizeCmd, "uniq-id of server to resize")
 	cloudServerResizeCmd.Flags().Int64("diskspace", -1, "desired diskspace (when private-parent)")
 	cloudServerResizeCmd.Flags().Int64("memory", -1, "desired memory (when private-parent)")
 	cloudServerResizeCmd.Flags().Bool("skip-fs-resize", false, "whether or not to skip the fs resize")
 	cloudServerResizeCmd.Flags().Int64("vcpu", -1, "desired vcpu count (when private-parent)")
-	cloudServerResizeCmd.Flags().Int64("config-id", cast.ToInt64(defaultFlag("cloud_server_resize_config-id", -1)),
+	cloudServerResizeCmd.Flags().Int64("config-id", -1,
 		"config-id of your desired config (when !private-parent) (see 'cloud server options --configs')")
-
-	if err := cloudServerResizeCmd.MarkFlagRequired("uniq-id"); err != nil {
-		lwCliInst.Die(err)
-	}
 }
@@ -18,11 +18,10 @@ package cmd
 import (
 	"errors"
 	"fmt"
-	"os"
 
 	"github.com/spf13/cobra"
 
-	"github.com/liquidweb/liquidweb-cli/types/api"
+	lwClient "github.com/liquidweb/liquidweb-cli/client"
 	"github.com/liquidweb/liquidweb-cli/utils"
 	"github.com/liquidweb/liquidweb-cli/validate"
 )
@@ -44,125 +43,125 @@ Its important to note, this command will *not* make any changes to your Cloud Se
 This command is purely for information gathering.
 `,
 	Run: func(cmd *cobra.Command, args []string) {
-		uniqIdFlag, _ := cmd.Flags().GetString("uniq-id")
+		targets := requireTargets(cmd)
 		privateParentFlag, _ := cmd.Flags().GetString("private-parent")
 		diskFlag, _ := cmd.Flags().GetInt64("diskspace")
 		memoryFlag, _ := cmd.Flags().GetInt64("memory")
 		vcpuFlag, _ := cmd.Flags().GetInt64("vcpu")
 		configIdFlag, _ := cmd.Flags().GetInt64("config-id")
 
-		validateFields := map[interface{}]interface{}{
-			uniqIdFlag: "UniqId",
-		}
-
-		if err := validate.Validate(validateFields); err != nil {
-			lwCliInst.Die(err)
-		}
-
 		if privateParentFlag != "" && configIdFlag != -1 {
 			lwCliInst.Die(errors.New("cant pass both --config-id and --private-parent flags"))
 		}
 		if privateParentFlag == "" && configIdFlag == -1 {
 			lwCliInst.Die(errors.New("must pass --config-id or --private-parent"))
 		}
-
-		apiArgs := map[string]interface{}{
-			"uniq_id":   uniqIdFlag,
-			"config_id": configIdFlag,
+		if privateParentFlag != "" && memoryFlag <= 0 && diskFlag <= 0 && vcpuFlag <= 0 {
+			lwCliInst.Die(errors.New("when --private-parent , at least one of --memory --disk --vcpu are required"))
 		}
 
-		// if private parent, add args
-		if privateParentFlag != "" {
-			if memoryFlag <= 0 && diskFlag <= 0 && vcpuFlag <= 0 {
-				lwCliInst.Die(errors.New("when --private-parent , at least one of --memory --disk --vcpu are required"))
+		runTargets(targets, func(uniqId string) error {
+			if len(targets) > 1 {
+				utils.PrintTeal("UniqId: %s\n", uniqId)
 			}
 
-			privateParentUniqId, _, err := lwCliInst.DerivePrivateParentUniqId(privateParentFlag)
-			if err != nil {
-				lwCliInst.Die(err)
+			validateFields := map[interface{}]interface{}{
+				uniqId: "UniqId",
 			}
-
-			var cloudServerDetails apiTypes.CloudServerDetails
-			if err = lwCliInst.CallLwApiInto(
-				"bleed/storm/server/details",
-				map[string]interface{}{
-					"uniq_id": uniqIdFlag,
-				}, &cloudServerDetails); err != nil {
-				lwCliInst.Die(err)
+			if err := validate.Validate(validateFields); err != nil {
+				return err
 			}
 
-			apiArgs["config_id"] = 0
-			apiArgs["private_parent"] = privateParentUniqId
-			apiArgs["disk"] = cloudServerDetails.DiskSpace
-			apiArgs["memory"] = cloudServerDetails.Memory
-			apiArgs["vcpu"] = cloudServerDetails.Vcpu
-
-			if diskFlag > 0 {
-				apiArgs["disk"] = diskFlag
+			resizePlanReq := &lwClient.StormServerResizePlanRequest{
+				UniqId:   uniqId,
+				ConfigId: configIdFlag,
 			}
-			if vcpuFlag > 0 {
-				apiArgs["vcpu"] = vcpuFlag
+
+			// if private parent, add args
+			if privateParentFlag != "" {
+				privateParentUniqId, _, err := lwCliInst.DerivePrivateParentUniqId(privateParentFlag)
+				if err != nil {
+					return err
+				}
+
+				cloudServerDetails, err := lwCliInst.Api.Storm.Server.Details(lwCliInst.Context(), uniqId)
+				if err != nil {
+					return err
+				}
+
+				resizePlanReq.ConfigId = 0
+				resizePlanReq.PrivateParent = privateParentUniqId
+				resizePlanReq.Disk = cloudServerDetails.DiskSpace
+				resizePlanReq.Memory = cloudServerDetails.Memory
+				resizePlanReq.Vcpu = cloudServerDetails.Vcpu
+
+				if diskFlag > 0 {
+					resizePlanReq.Disk = diskFlag
+				}
+				if vcpuFlag > 0 {
+					resizePlanReq.Vcpu = vcpuFlag
+				}
+				if memoryFlag > 0 {
+					resizePlanReq.Memory = memoryFlag
+				}
 			}
-			if memoryFlag > 0 {
-				apiArgs["memory"] = memoryFlag
+
+			expectation, err := lwCliInst.Api.Storm.Server.ResizePlan(lwCliInst.Context(), resizePlanReq)
+			if err != nil {
+				utils.PrintRed("Configuration Not Available\n\n")
+				return err
 			}
-		}
 
-		var expectation apiTypes.CloudServerResizeExpectation
-		err := lwCliInst.CallLwApiInto("bleed/storm/server/resizePlan", apiArgs, &expectation)
-		if err != nil {
-			utils.PrintRed("Configuration Not Available\n\n")
-			fmt.Printf("%s\n", err)
-			os.Exit(1)
-		}
+			utils.PrintGreen("Configuration Available\n\n")
+
+			fmt.Print("Resource Changes: Disk [")
+			if expectation.DiskDifference == 0 {
+				fmt.Printf("%d] ", expectation.DiskDifference)
+			} else if expectation.DiskDifference >= 0 {
+				utils.PrintGreen("%+d", expectation.DiskDifference)
+				fmt.Print("] ")
+			} else {
+				utils.PrintRed("%d", expectation.DiskDifference)
+				fmt.Print("] ")
+			}
 
-		utils.PrintGreen("Configuration Available\n\n")
-
-		fmt.Print("Resource Changes: Disk [")
-		if expectation.DiskDifference == 0 {
-			fmt.Printf("%d] ", expectation.DiskDifference)
-		} else if expectation.DiskDifference >= 0 {
-			utils.PrintGreen("%d", expectation.DiskDifference)
-			fmt.Print("] ")
-		} else {
-			utils.PrintRed("%d", expectation.DiskDifference)
-			fmt.Print("] ")
-		}
+			fmt.Print("Memory [")
+			if expectation.MemoryDifference == 0 {
+				fmt.Printf("%d] ", expectation.MemoryDifference)
+			} else if expectation.MemoryDifference >= 0 {
+				utils.PrintGreen("%+d", expectation.MemoryDifference)
+				fmt.Print("] ")
+			} else {
+				utils.PrintRed("%d", expectation.MemoryDifference)
+				fmt.Print("] ")
+			}
 
-		fmt.Print("Memory [")
-		if expectation.MemoryDifference == 0 {
-			fmt.Printf("%d] ", expectation.MemoryDifference)
-		} else if expectation.MemoryDifference >= 0 {
-			utils.PrintGreen("%d", expectation.MemoryDifference)
-			fmt.Print("] ")
-		} else {
-			utils.PrintRed("%d", expectation.MemoryDifference)
-			fmt.Print("] ")
-		}
+			fmt.Print("Vcpu [")
+			if expectation.VcpuDifference == 0 {
+				fmt.Printf("%d]\n", expectation.VcpuDifference)
+			} else if expectation.VcpuDifference >= 0 {
+				utils.PrintGreen("%+d", expectation.VcpuDifference)
+				fmt.Print("]\n")
+			} else {
+				utils.PrintRed("%d", expectation.VcpuDifference)
+				fmt.Print("]\n")
+			}
 
-		fmt.Print("Vcpu [")
-		if expectation.VcpuDifference == 0 {
-			fmt.Printf("%d]\n", expectation.VcpuDifference)
-		} else if expectation.VcpuDifference >= 0 {
-			utils.PrintGreen("%d", expectation.VcpuDifference)
-			fmt.Print("]\n")
-		} else {
-			utils.PrintRed("%d", expectation.VcpuDifference)
-			fmt.Print("]\n")
-		}
+			if expectation.RebootRequired {
+				utils.PrintYellow("\nReboot required.\n")
+			} else {
+				utils.PrintGreen("\nNo reboot required.\n")
+			}
 
-		if expectation.RebootRequired {
-			utils.PrintYellow("\nReboot required.\n")
-		} else {
-			utils.PrintGreen("\nNo reboot required.\n")
-		}
+			return nil
+		})
 	},
 }
 
 func init() {
 	cloudServerCmd.AddCommand(cloudServerResizeExpectationCmd)
 
-	cloudServerResizeExpectationCmd.Flags().String("uniq-id", "", "uniq-id of Cloud Server")
+	addTargetFlags(cloudServerResizeExpectationCmd, "uniq-id of Cloud Server")
 
 	cloudServerResizeExpectationCmd.Flags().String("private-parent", "",
 		"name or uniq-id of the Private Parent (see: 'cloud private-parent list')")
@@ -172,8 +171,4 @@ func init() {
 
 	cloudServerResizeExpectationCmd.Flags().Int64("config-id", -1,
 		"config-id to check availability for (when !private-parent) (see: 'cloud server options --configs')")
-
-	if err := cloudServerResizeExpectationCmd.MarkFlagRequired("uniq-id"); err != nil {
-		lwCliInst.Die(err)
-	}
 }
@@ -20,7 +20,7 @@ import (
 
 	"github.com/spf13/cobra"
 
-	"github.com/liquidweb/liquidweb-cli/types/api"
+	"github.com/liquidweb/liquidweb-cli/output"
 	"github.com/liquidweb/liquidweb-cli/validate"
 )
 
@@ -32,52 +32,35 @@ var cloudServerShutdownCmd = &cobra.Command{
 Stop a server. The 'force' flag will do a hard stop of the server from the parent server. Otherwise, it
 will issue a halt command to the server and shutdown normally.`,
 	Run: func(cmd *cobra.Command, args []string) {
-		uniqIdFlag, _ := cmd.Flags().GetString("uniq-id")
 		forceFlag, _ := cmd.Flags().GetBool("force")
-		jsonFlag, _ := cmd.Flags().GetBool("json")
 
-		validateFields := map[interface{}]interface{}{
-			uniqIdFlag: "UniqId",
-		}
-		if err := validate.Validate(validateFields); err != nil {
-			lwCliInst.Die(err)
-		}
-
-		shutdownArgs := map[string]interface{}{
-			"uniq_id": uniqIdFlag,
-			//"force":   forceFlag,
-		}
-		// conditionally adding to workaround bug in api method..
-		// TODO delete and just always add once bug addressed
-		if forceFlag {
-			shutdownArgs["force"] = forceFlag
-		}
-
-		var result apiTypes.CloudServerShutdownResponse
-		if err := lwCliInst.CallLwApiInto("bleed/server/shutdown", shutdownArgs, &result); err != nil {
-			lwCliInst.Die(err)
-		}
+		runTargets(requireTargets(cmd), func(uniqId string) error {
+			validateFields := map[interface{}]interface{}{
+				uniqId: "UniqId",
+			}
+			if err := validate.Validate(validateFields); err != nil {
+				return err
+			}
 
-		if jsonFlag {
-			pretty, err := lwCliInst.JsonEncodeAndPrettyPrint(result)
+			result, err := lwCliInst.Api.Server.Shutdown(lwCliInst.Context(), uniqId, forceFlag)
 			if err != nil {
-				lwCliInst.Die(err)
+				return err
 			}
-			fmt.Printf(pretty)
-		} else {
-			fmt.Printf("shutdown: %s\n", result.Shutdown)
-		}
 
+			if cmdOutputFormat(cmd) != output.Text {
+				printResult(cmd, result)
+			} else {
+				fmt.Printf("shutdown: %s\n", result.Shutdown)
+			}
+
+			return nil
+		})
 	},
 }
 
 func init() {
 	cloudServerCmd.AddCommand(cloudServerShutdownCmd)
-	cloudServerShutdownCmd.Flags().Bool("json", false, "output in json format")
-	cloudServerShutdownCmd.Flags().String("uniq-id", "", "uniq-id of server to shutdown")
+	cloudServerShutdownCmd.Flags().Bool("json", false, "output in json format (same as --output json)")
+	addTargetFlags(cloudServerShutdownCmd, "uniq-id of server to shutdown")
 	cloudServerShutdownCmd.Flags().Bool("force", false, "force shutdown server")
-
-	if err := cloudServerShutdownCmd.MarkFlagRequired("uniq-id"); err != nil {
-		lwCliInst.Die(err)
-	}
 }
@@ -20,7 +20,7 @@ import (
 
 	"github.com/spf13/cobra"
 
-	"github.com/liquidweb/liquidweb-cli/types/api"
+	"github.com/liquidweb/liquidweb-cli/output"
 	"github.com/liquidweb/liquidweb-cli/validate"
 )
 
@@ -31,44 +31,32 @@ var cloudServerStartCmd = &cobra.Command{
 
 Boot a server. If the server is already running, this will do nothing.`,
 	Run: func(cmd *cobra.Command, args []string) {
-		uniqIdFlag, _ := cmd.Flags().GetString("uniq-id")
-		jsonFlag, _ := cmd.Flags().GetBool("json")
-
-		validateFields := map[interface{}]interface{}{
-			uniqIdFlag: "UniqId",
-		}
-		if err := validate.Validate(validateFields); err != nil {
-			lwCliInst.Die(err)
-		}
-
-		startArgs := map[string]interface{}{
-			"uniq_id": uniqIdFlag,
-		}
-
-		var result apiTypes.CloudServerStartResponse
-		if err := lwCliInst.CallLwApiInto("bleed/server/start", startArgs, &result); err != nil {
-			lwCliInst.Die(err)
-		}
+		runTargets(requireTargets(cmd), func(uniqId string) error {
+			validateFields := map[interface{}]interface{}{
+				uniqId: "UniqId",
+			}
+			if err := validate.Validate(validateFields); err != nil {
+				return err
+			}
 
-		if jsonFlag {
-			pretty, err := lwCliInst.JsonEncodeAndPrettyPrint(result)
+			result, err := lwCliInst.Api.Server.Start(lwCliInst.Context(), uniqId)
 			if err != nil {
-				lwCliInst.Die(err)
+				return err
+			}
+
+			if cmdOutputFormat(cmd) != output.Text {
+				printResult(cmd, result)
+			} else {
+				fmt.Printf("started: %s\n", result.Started)
 			}
-			fmt.Printf(pretty)
-		} else {
-			fmt.Printf("started: %s\n", result.Started)
-		}
 
+			return nil
+		})
 	},
 }
 
 func init() {
 	cloudServerCmd.AddCommand(cloudServerStartCmd)
-	cloudServerStartCmd.Flags().Bool("json", false, "output in json format")
-	cloudServerStartCmd.Flags().String("uniq-id", "", "uniq-id of server to start")
-
-	if err := cloudServerStartCmd.MarkFlagRequired("uniq-id"); err != nil {
-		lwCliInst.Die(err)
-	}
+	cloudServerStartCmd.Flags().Bool("json", false, "output in json format (same as --output json)")
+	addTargetFlags(cloudServerStartCmd, "uniq-id of server to start")
 }
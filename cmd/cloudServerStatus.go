@@ -22,14 +22,12 @@ import (
 
 	"github.com/spf13/cobra"
 
-	"github.com/liquidweb/liquidweb-cli/instance"
+	lwClient "github.com/liquidweb/liquidweb-cli/client"
 	"github.com/liquidweb/liquidweb-cli/types/api"
 	"github.com/liquidweb/liquidweb-cli/utils"
 	"github.com/liquidweb/liquidweb-cli/validate"
 )
 
-var cloudServerStatusCmdUniqIdFlag []string
-
 var cloudServerStatusCmd = &cobra.Command{
 	Use:   "status",
 	Short: "Get status of Cloud Server(s)",
@@ -70,19 +68,26 @@ If nothing is currently running, only the 'status' field will be returned with o
 	Run: func(cmd *cobra.Command, args []string) {
 		watchFlag, _ := cmd.Flags().GetBool("watch")
 		intervalFlag, _ := cmd.Flags().GetInt("interval")
+		targets := cmdTargets(cmd)
 
 		if watchFlag {
 			if intervalFlag <= 0 {
 				lwCliInst.Die(fmt.Errorf("You must specify an interval greater than zero."))
 			}
 
+			ctx := lwCliInst.Context()
 			for {
 				fmt.Println("\nDisplaying server status (CTRL-C to exit):")
-				displayCloudSErverStatus(cloudServerStatusCmdUniqIdFlag)
-				time.Sleep(time.Duration(intervalFlag) * time.Second)
+				displayCloudSErverStatus(targets)
+
+				select {
+				case <-ctx.Done():
+					return
+				case <-time.After(time.Duration(intervalFlag) * time.Second):
+				}
 			}
 		} else {
-			displayCloudSErverStatus(cloudServerStatusCmdUniqIdFlag)
+			displayCloudSErverStatus(targets)
 		}
 	},
 }
@@ -91,34 +96,23 @@ func displayCloudSErverStatus(uniqIdList []string) {
 
 	if len(uniqIdList) == 0 {
 		// fetch status of all cloud servers on account
-		methodArgs := instance.AllPaginatedResultsArgs{
-			Method:         "bleed/storm/server/list",
-			ResultsPerPage: 100,
-		}
-		results, err := lwCliInst.AllPaginatedResults(&methodArgs)
+		err := lwCliInst.Api.Storm.Server.Each(lwCliInst.Context(), lwClient.ListOptions{PageSize: 100},
+			func(details *apiTypes.CloudServerDetails) (bool, error) {
+				return true, _printCloudServerStatus(details.UniqId, details.Domain)
+			})
 		if err != nil {
 			lwCliInst.Die(err)
 		}
-
-		for _, item := range results.Items {
-			var details apiTypes.CloudServerDetails
-			if err := instance.CastFieldTypes(item, &details); err != nil {
-				lwCliInst.Die(err)
-			}
-
-			_printCloudServerStatus(details.UniqId, details.Domain)
-		}
 	} else {
-		for _, uid := range uniqIdList {
+		runTargets(uniqIdList, func(uid string) error {
 			validateFields := map[interface{}]interface{}{
 				uid: "UniqId",
 			}
 			if err := validate.Validate(validateFields); err != nil {
-				fmt.Printf("%s ... skipping\n", err)
-				continue
+				return err
 			}
-			_printCloudServerStatus(uid, "")
-		}
+			return _printCloudServerStatus(uid, "")
+		})
 	}
 
 }
@@ -126,24 +120,21 @@ func displayCloudSErverStatus(uniqIdList []string) {
 func init() {
 	cloudServerCmd.AddCommand(cloudServerStatusCmd)
 
-	cloudServerStatusCmd.Flags().StringSliceVar(&cloudServerStatusCmdUniqIdFlag, "uniq-id", []string{},
-		"uniq-id(s) to get status of. For multiple, must be ',' separated")
+	addTargetFlags(cloudServerStatusCmd, "uniq-id(s) to get status of; all Cloud Servers when not given")
 	cloudServerStatusCmd.Flags().Bool("watch", false, "continue to redisplay status at --interval")
 	cloudServerStatusCmd.Flags().Int("interval", 10, "the interval (in seconds) to fetch the status when --watch is specified")
 }
 
-func _printCloudServerStatus(uniqId string, domain string) {
-	var status apiTypes.CloudServerStatus
-	if err := lwCliInst.CallLwApiInto("bleed/storm/server/status", map[string]interface{}{"uniq_id": uniqId},
-		&status); err != nil {
-		lwCliInst.Die(err)
+func _printCloudServerStatus(uniqId string, domain string) error {
+	status, err := lwCliInst.Api.Storm.Server.Status(lwCliInst.Context(), uniqId)
+	if err != nil {
+		return err
 	}
 
 	if domain == "" {
-		var details apiTypes.CloudServerDetails
-		if err := lwCliInst.CallLwApiInto("bleed/storm/server/details",
-			map[string]interface{}{"uniq_id": uniqId}, &details); err != nil {
-			lwCliInst.Die(err)
+		details, err := lwCliInst.Api.Storm.Server.Details(lwCliInst.Context(), uniqId)
+		if err != nil {
+			return err
 		}
 		domain = details.Domain
 	}
@@ -160,4 +151,6 @@ func _printCloudServerStatus(uniqId string, domain string) {
 		fmt.Printf("\trunning: %+v\n", status.Running)
 		fmt.Printf("\tprogress: %+v\n", status.Progress)
 	}
+
+	return nil
 }
@@ -20,7 +20,8 @@ import (
 
 	"github.com/spf13/cobra"
 
-	"github.com/liquidweb/liquidweb-cli/types/api"
+	lwClient "github.com/liquidweb/liquidweb-cli/client"
+	"github.com/liquidweb/liquidweb-cli/types/errors"
 	"github.com/liquidweb/liquidweb-cli/validate"
 )
 
@@ -42,20 +43,13 @@ A daily backup plan will save backups of a server up to your set days.
 Either backup plan has a maximum retention of 90 days.
 `,
 	Run: func(cmd *cobra.Command, args []string) {
-		uniqIdFlag, _ := cmd.Flags().GetString("uniq-id")
+		targets := requireTargets(cmd)
 		hostnameFlag, _ := cmd.Flags().GetString("hostname")
 		disableBackupsFlag, _ := cmd.Flags().GetBool("disable-backups")
 		bandwidthQuotaFlag, _ := cmd.Flags().GetInt64("bandwidth-quota")
 		backupDaysFlag, _ := cmd.Flags().GetInt64("backup-days")
 		backupQuotaFlag, _ := cmd.Flags().GetInt64("backup-quota")
 
-		validateFields := map[interface{}]interface{}{
-			uniqIdFlag: "UniqId",
-		}
-		if err := validate.Validate(validateFields); err != nil {
-			lwCliInst.Die(err)
-		}
-
 		if backupDaysFlag != -1 && backupQuotaFlag != -1 {
 			lwCliInst.Die(fmt.Errorf("--backup-days and --backup-quota are conflicting flags"))
 		}
@@ -71,48 +65,58 @@ Either backup plan has a maximum retention of 90 days.
 			}
 		}
 
-		apiArgs := map[string]interface{}{
-			"uniq_id": uniqIdFlag,
+		if hostnameFlag != "" && len(targets) > 1 {
+			lwCliInst.Die(fmt.Errorf("%w: --hostname can only be set on a single Cloud Server",
+				errorTypes.LwCliInvalidFlagValue))
 		}
 
-		if hostnameFlag != "" {
-			apiArgs["domain"] = hostnameFlag
-		}
-		if bandwidthQuotaFlag != -1 {
-			apiArgs["bandwidth_quota"] = bandwidthQuotaFlag
-		}
+		runTargets(targets, func(uniqId string) error {
+			validateFields := map[interface{}]interface{}{
+				uniqId: "UniqId",
+			}
+			if err := validate.Validate(validateFields); err != nil {
+				return err
+			}
 
-		if backupDaysFlag != -1 {
-			apiArgs["backup_plan"] = "daily"
-			apiArgs["backup_quota"] = backupDaysFlag
-		} else if backupQuotaFlag != -1 {
-			apiArgs["backup_plan"] = "quota"
-			apiArgs["backup_quota"] = backupQuotaFlag
-		} else if disableBackupsFlag {
-			apiArgs["backup_plan"] = "None"
-		}
+			updateReq := &lwClient.StormServerUpdateRequest{
+				UniqId: uniqId,
+				Domain: hostnameFlag,
+			}
 
-		var details apiTypes.CloudServerDetails
-		if err := lwCliInst.CallLwApiInto("bleed/storm/server/update", apiArgs, &details); err != nil {
-			lwCliInst.Die(err)
-		}
+			if bandwidthQuotaFlag != -1 {
+				updateReq.BandwidthQuota = &bandwidthQuotaFlag
+			}
+
+			if backupDaysFlag != -1 {
+				updateReq.BackupPlan = "daily"
+				updateReq.BackupQuota = &backupDaysFlag
+			} else if backupQuotaFlag != -1 {
+				updateReq.BackupPlan = "quota"
+				updateReq.BackupQuota = &backupQuotaFlag
+			} else if disableBackupsFlag {
+				updateReq.BackupPlan = "None"
+			}
 
-		_printExtendedCloudServerDetails(&details)
+			details, err := lwCliInst.Api.Storm.Server.Update(lwCliInst.Context(), updateReq)
+			if err != nil {
+				return err
+			}
+
+			_printExtendedCloudServerDetails(details)
+
+			return nil
+		})
 	},
 }
 
 func init() {
 	cloudServerCmd.AddCommand(cloudServerUpdateCmd)
 
-	cloudServerUpdateCmd.Flags().String("uniq-id", "", "uniq-id of the Cloud Server")
+	addTargetFlags(cloudServerUpdateCmd, "uniq-id of the Cloud Server")
 	cloudServerUpdateCmd.Flags().String("hostname", "", "hostname to set (will only update record at LiquidWeb)")
 	cloudServerUpdateCmd.Flags().Int64("backup-days", -1, "Enable daily backup plan. This is the amount of days to keep a backup")
 	cloudServerUpdateCmd.Flags().Int64("backup-quota", -1, "Enable quota backup plan. This is the total amount of GB to keep.")
 	cloudServerUpdateCmd.Flags().Bool("disable-backups", false, "disable backups")
 	cloudServerUpdateCmd.Flags().Int64("bandwidth-quota", -1,
 		"bandwidth quota (0 indicates as-you-go, usage-based bandwidth charges)")
-
-	if err := cloudServerUpdateCmd.MarkFlagRequired("uniq-id"); err != nil {
-		lwCliInst.Die(err)
-	}
 }
@@ -20,7 +20,6 @@ import (
 
 	"github.com/spf13/cobra"
 
-	"github.com/liquidweb/liquidweb-cli/types/api"
 	"github.com/liquidweb/liquidweb-cli/validate"
 )
 
@@ -33,45 +32,37 @@ Block storage offers a method to attach additional storage to Cloud Server.
 Once attached, volumes appear as normal block devices, and can be used as such.
 `,
 	Run: func(cmd *cobra.Command, args []string) {
-		uniqIdFlag, _ := cmd.Flags().GetString("uniq-id")
 		attachToFlag, _ := cmd.Flags().GetString("attach-to")
 
-		validateFields := map[interface{}]interface{}{
-			uniqIdFlag:   "UniqId",
-			attachToFlag: "UniqId",
-		}
-		if err := validate.Validate(validateFields); err != nil {
-			lwCliInst.Die(err)
-		}
+		runTargets(requireTargets(cmd), func(uniqId string) error {
+			validateFields := map[interface{}]interface{}{
+				uniqId:       "UniqId",
+				attachToFlag: "UniqId",
+			}
+			if err := validate.Validate(validateFields); err != nil {
+				return err
+			}
 
-		apiArgs := map[string]interface{}{
-			"uniq_id": uniqIdFlag,
-			"to":      attachToFlag,
-		}
+			details, err := lwCliInst.Api.Storage.Block.Volume.Attach(lwCliInst.Context(), uniqId, attachToFlag)
+			if err != nil {
+				return err
+			}
 
-		var details apiTypes.CloudBlockStorageVolumeAttach
-		err := lwCliInst.CallLwApiInto("bleed/storage/block/volume/attach",
-			apiArgs, &details)
-		if err != nil {
-			lwCliInst.Die(err)
-		}
+			fmt.Printf("Attached Block Storage Volume %s to Cloud Server %s\n",
+				details.Attached, details.To)
 
-		fmt.Printf("Attached Block Storage Volume %s to Cloud Server %s\n",
-			details.Attached, details.To)
+			return nil
+		})
 	},
 }
 
 func init() {
 	cloudStorageBlockVolumeCmd.AddCommand(cloudStorageBlockVolumeAttachCmd)
 
-	cloudStorageBlockVolumeAttachCmd.Flags().String("uniq-id", "",
-		"uniq-id of Cloud Block Storage Volume")
+	addTargetFlags(cloudStorageBlockVolumeAttachCmd, "uniq-id of Cloud Block Storage Volume")
 	cloudStorageBlockVolumeAttachCmd.Flags().String("attach-to", "",
 		"uniq-id of Cloud Server to attach to")
 
-	if err := cloudStorageBlockVolumeAttachCmd.MarkFlagRequired("uniq-id"); err != nil {
-		lwCliInst.Die(err)
-	}
 	if err := cloudStorageBlockVolumeAttachCmd.MarkFlagRequired("attach-to"); err != nil {
 		lwCliInst.Die(err)
 	}
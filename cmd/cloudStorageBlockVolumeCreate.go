@@ -20,7 +20,8 @@ import (
 
 	"github.com/spf13/cobra"
 
-	"github.com/liquidweb/liquidweb-cli/types/api"
+	lwClient "github.com/liquidweb/liquidweb-cli/client"
+	"github.com/liquidweb/liquidweb-cli/output"
 	"github.com/liquidweb/liquidweb-cli/utils"
 	"github.com/liquidweb/liquidweb-cli/validate"
 )
@@ -48,29 +49,29 @@ Once attached, volumes appear as normal block devices, and can be used as such.
 			lwCliInst.Die(err)
 		}
 
-		apiArgs := map[string]interface{}{
-			"domain":       nameFlag,
-			"size":         sizeFlag,
-			"cross_attach": crossAttachFlag,
+		createReq := &lwClient.StorageBlockVolumeCreateRequest{
+			Domain:      nameFlag,
+			Size:        sizeFlag,
+			CrossAttach: crossAttachFlag,
+			Attach:      attachFlag,
 		}
-
-		if attachFlag != "" {
-			apiArgs["attach"] = attachFlag
-		}
-
 		if regionFlag != -1 {
-			apiArgs["region"] = regionFlag
+			createReq.Region = regionFlag
 		}
 		if zoneFlag != -1 {
-			apiArgs["zone"] = zoneFlag
+			createReq.Zone = zoneFlag
 		}
 
-		var details apiTypes.CloudBlockStorageVolumeDetails
-		err := lwCliInst.CallLwApiInto("bleed/storage/block/volume/create", apiArgs, &details)
+		details, err := lwCliInst.Api.Storage.Block.Volume.Create(lwCliInst.Context(), createReq)
 		if err != nil {
 			lwCliInst.Die(err)
 		}
 
+		if cmdOutputFormat(cmd) != output.Text {
+			printResult(cmd, details)
+			return
+		}
+
 		fmt.Printf("Created Cloud Block Storage Volume\n%s", details)
 	},
 }
@@ -21,7 +21,6 @@ import (
 
 	"github.com/spf13/cobra"
 
-	"github.com/liquidweb/liquidweb-cli/types/api"
 	"github.com/liquidweb/liquidweb-cli/validate"
 )
 
@@ -34,7 +33,7 @@ Block storage offers a method to attach additional storage to Cloud Server.
 Once attached, volumes appear as normal block devices, and can be used as such.
 `,
 	Run: func(cmd *cobra.Command, args []string) {
-		uniqIdFlag, _ := cmd.Flags().GetString("uniq-id")
+		targets := requireTargets(cmd)
 		forceFlag, _ := cmd.Flags().GetBool("force")
 
 		// if force flag wasn't passed
@@ -45,31 +44,29 @@ Once attached, volumes appear as normal block devices, and can be used as such.
 			}
 		}
 
-		validateFields := map[interface{}]interface{}{
-			uniqIdFlag: "UniqId",
-		}
-		if err := validate.Validate(validateFields); err != nil {
-			lwCliInst.Die(err)
-		}
+		runTargets(targets, func(uniqId string) error {
+			validateFields := map[interface{}]interface{}{
+				uniqId: "UniqId",
+			}
+			if err := validate.Validate(validateFields); err != nil {
+				return err
+			}
 
-		apiArgs := map[string]interface{}{"uniq_id": uniqIdFlag}
-		var details apiTypes.CloudBlockStorageVolumeDelete
-		err := lwCliInst.CallLwApiInto("bleed/storage/block/volume/delete", apiArgs, &details)
-		if err != nil {
-			lwCliInst.Die(err)
-		}
+			details, err := lwCliInst.Api.Storage.Block.Volume.Delete(lwCliInst.Context(), uniqId)
+			if err != nil {
+				return err
+			}
 
-		fmt.Printf("Deleted Cloud Block Storage Volume: %s\n", details.Deleted)
+			fmt.Printf("Deleted Cloud Block Storage Volume: %s\n", details.Deleted)
+
+			return nil
+		})
 	},
 }
 
 func init() {
 	cloudStorageBlockVolumeCmd.AddCommand(cloudStorageBlockVolumeDeleteCmd)
 
-	cloudStorageBlockVolumeDeleteCmd.Flags().String("uniq-id", "", "uniq-id of Cloud Block Storage volume")
+	addTargetFlags(cloudStorageBlockVolumeDeleteCmd, "uniq-id of Cloud Block Storage volume")
 	cloudStorageBlockVolumeDeleteCmd.Flags().Bool("force", false, "bypass dialog confirmation")
-
-	if err := cloudStorageBlockVolumeDeleteCmd.MarkFlagRequired("uniq-id"); err != nil {
-		lwCliInst.Die(err)
-	}
 }
@@ -20,7 +20,6 @@ import (
 
 	"github.com/spf13/cobra"
 
-	"github.com/liquidweb/liquidweb-cli/types/api"
 	"github.com/liquidweb/liquidweb-cli/validate"
 )
 
@@ -33,45 +32,37 @@ Block storage offers a method to attach additional storage to Cloud Server.
 Once attached, volumes appear as normal block devices, and can be used as such.
 `,
 	Run: func(cmd *cobra.Command, args []string) {
-		uniqIdFlag, _ := cmd.Flags().GetString("uniq-id")
 		detachFromFlag, _ := cmd.Flags().GetString("detach-from")
 
-		validateFields := map[interface{}]interface{}{
-			uniqIdFlag:     "UniqId",
-			detachFromFlag: "UniqId",
-		}
-		if err := validate.Validate(validateFields); err != nil {
-			lwCliInst.Die(err)
-		}
+		runTargets(requireTargets(cmd), func(uniqId string) error {
+			validateFields := map[interface{}]interface{}{
+				uniqId:         "UniqId",
+				detachFromFlag: "UniqId",
+			}
+			if err := validate.Validate(validateFields); err != nil {
+				return err
+			}
 
-		apiArgs := map[string]interface{}{
-			"uniq_id":     uniqIdFlag,
-			"detach_from": detachFromFlag,
-		}
+			details, err := lwCliInst.Api.Storage.Block.Volume.Detach(lwCliInst.Context(), uniqId, detachFromFlag)
+			if err != nil {
+				return err
+			}
 
-		var details apiTypes.CloudBlockStorageVolumeDetach
-		err := lwCliInst.CallLwApiInto("bleed/storage/block/volume/detach",
-			apiArgs, &details)
-		if err != nil {
-			lwCliInst.Die(err)
-		}
+			fmt.Printf("Detached Block Storage Volume %s from Cloud Server %s\n",
+				details.Detached, details.DetachedFrom)
 
-		fmt.Printf("Detached Block Storage Volume %s from Cloud Server %s\n",
-			details.Detached, details.DetachedFrom)
+			return nil
+		})
 	},
 }
 
 func init() {
 	cloudStorageBlockVolumeCmd.AddCommand(cloudStorageBlockVolumeDetachCmd)
 
-	cloudStorageBlockVolumeDetachCmd.Flags().String("uniq-id", "",
-		"uniq-id of Cloud Block Storage Volume")
+	addTargetFlags(cloudStorageBlockVolumeDetachCmd, "uniq-id of Cloud Block Storage Volume")
 	cloudStorageBlockVolumeDetachCmd.Flags().String("detach-from", "",
 		"uniq-id of Cloud Server to detach from")
 
-	if err := cloudStorageBlockVolumeDetachCmd.MarkFlagRequired("uniq-id"); err != nil {
-		lwCliInst.Die(err)
-	}
 	if err := cloudStorageBlockVolumeDetachCmd.MarkFlagRequired("detach-from"); err != nil {
 		lwCliInst.Die(err)
 	}
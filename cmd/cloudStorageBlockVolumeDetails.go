@@ -16,11 +16,8 @@ limitations under the License.
 package cmd
 
 import (
-	"fmt"
-
 	"github.com/spf13/cobra"
 
-	"github.com/liquidweb/liquidweb-cli/types/api"
 	"github.com/liquidweb/liquidweb-cli/validate"
 )
 
@@ -33,44 +30,29 @@ Block storage offers a method to attach additional storage to Cloud Server.
 Once attached, volumes appear as normal block devices, and can be used as such.
 `,
 	Run: func(cmd *cobra.Command, args []string) {
-		uniqIdFlag, _ := cmd.Flags().GetString("uniq-id")
-		jsonFlag, _ := cmd.Flags().GetBool("json")
-
-		validateFields := map[interface{}]interface{}{
-			uniqIdFlag: "UniqId",
-		}
-		if err := validate.Validate(validateFields); err != nil {
-			lwCliInst.Die(err)
-		}
-
-		apiArgs := map[string]interface{}{"uniq_id": uniqIdFlag}
-
-		var details apiTypes.CloudBlockStorageVolumeDetails
-		err := lwCliInst.CallLwApiInto("bleed/storage/block/volume/details", apiArgs, &details)
-		if err != nil {
-			lwCliInst.Die(err)
-		}
+		runTargets(requireTargets(cmd), func(uniqId string) error {
+			validateFields := map[interface{}]interface{}{
+				uniqId: "UniqId",
+			}
+			if err := validate.Validate(validateFields); err != nil {
+				return err
+			}
 
-		if jsonFlag {
-			pretty, err := lwCliInst.JsonEncodeAndPrettyPrint(details)
+			details, err := lwCliInst.Api.Storage.Block.Volume.Details(lwCliInst.Context(), uniqId)
 			if err != nil {
-				lwCliInst.Die(err)
+				return err
 			}
-			fmt.Printf(pretty)
-		} else {
-			fmt.Print(details)
 
-		}
+			printResult(cmd, details)
+
+			return nil
+		})
 	},
 }
 
 func init() {
 	cloudStorageBlockVolumeCmd.AddCommand(cloudStorageBlockVolumeDetailsCmd)
 
-	cloudStorageBlockVolumeDetailsCmd.Flags().Bool("json", false, "output in json format")
-	cloudStorageBlockVolumeDetailsCmd.Flags().String("uniq-id", "", "uniq-id of Cloud Block Storage volume")
-
-	if err := cloudStorageBlockVolumeDetailsCmd.MarkFlagRequired("uniq-id"); err != nil {
-		lwCliInst.Die(err)
-	}
+	cloudStorageBlockVolumeDetailsCmd.Flags().Bool("json", false, "output in json format (same as --output json)")
+	addTargetFlags(cloudStorageBlockVolumeDetailsCmd, "uniq-id of Cloud Block Storage volume")
 }
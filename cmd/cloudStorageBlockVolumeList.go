@@ -20,7 +20,8 @@ import (
 
 	"github.com/spf13/cobra"
 
-	"github.com/liquidweb/liquidweb-cli/instance"
+	lwClient "github.com/liquidweb/liquidweb-cli/client"
+	"github.com/liquidweb/liquidweb-cli/output"
 	"github.com/liquidweb/liquidweb-cli/types/api"
 )
 
@@ -29,35 +30,48 @@ var cloudStorageBlockVolumeListCmd = &cobra.Command{
 	Short: "List Cloud Block Storage volumes on your account",
 	Long:  `List Cloud Block Storage volumes on your account`,
 	Run: func(cmd *cobra.Command, args []string) {
-		methodArgs := instance.AllPaginatedResultsArgs{
-			Method:         "bleed/storage/block/volume/list",
-			ResultsPerPage: 100,
+		listQuery := newListQuery(cmd)
+		listOpts := lwClient.ListOptions{
+			PageSize: 100,
+			Limit:    listQuery.ListLimit(),
 		}
-		results, err := lwCliInst.AllPaginatedResults(&methodArgs)
-		if err != nil {
-			lwCliInst.Die(err)
-		}
-
-		jsonOutput, _ := cmd.Flags().GetBool("json")
-		if jsonOutput {
 
-			pretty, err := lwCliInst.JsonEncodeAndPrettyPrint(results)
+		printer := newListPrinter(cmd)
+		if printer.Format.Encoded() {
+			results, err := lwCliInst.Api.Storage.Block.Volume.All(lwCliInst.Context(), listOpts)
 			if err != nil {
 				lwCliInst.Die(err)
 			}
-			fmt.Printf(pretty)
-		} else {
-			cnt := 1
-			for _, item := range results.Items {
-
-				var details apiTypes.CloudBlockStorageVolumeDetails
-				if err := instance.CastFieldTypes(item, &details); err != nil {
-					lwCliInst.Die(err)
-				}
+			if results.Items, err = listQuery.Items(results.Items); err != nil {
+				lwCliInst.Die(err)
+			}
+			if err := printer.Print(results); err != nil {
+				lwCliInst.Die(err)
+			}
+			return
+		}
 
-				fmt.Printf("%d.) %s", cnt, details)
-				cnt++
+		cnt := 1
+		err := listQuery.Run(func(visit output.ItemFunc) error {
+			return lwCliInst.Api.Storage.Block.Volume.Each(lwCliInst.Context(), listOpts, func(details *apiTypes.CloudBlockStorageVolumeDetails) (bool, error) {
+				return visit(details)
+			})
+		}, func(item interface{}) (bool, error) {
+			details := item.(*apiTypes.CloudBlockStorageVolumeDetails)
+			if printer.Format != output.Text {
+				return true, printer.Add(details)
 			}
+
+			fmt.Printf("%d.) %s", cnt, details)
+			cnt++
+
+			return true, nil
+		})
+		if err != nil {
+			lwCliInst.Die(err)
+		}
+		if err := printer.Flush(); err != nil {
+			lwCliInst.Die(err)
 		}
 	},
 }
@@ -65,5 +79,7 @@ var cloudStorageBlockVolumeListCmd = &cobra.Command{
 func init() {
 	cloudStorageBlockVolumeCmd.AddCommand(cloudStorageBlockVolumeListCmd)
 
-	cloudStorageBlockVolumeListCmd.Flags().Bool("json", false, "output in json format")
+	cloudStorageBlockVolumeListCmd.Flags().Bool("json", false, "output in json format (same as --output json)")
+	cloudStorageBlockVolumeListCmd.Flags().Int64("limit", 0, "stop after listing this many volumes (0 for no limit)")
+	addListQueryFlags(cloudStorageBlockVolumeListCmd)
 }
@@ -20,7 +20,6 @@ import (
 
 	"github.com/spf13/cobra"
 
-	"github.com/liquidweb/liquidweb-cli/types/api"
 	"github.com/liquidweb/liquidweb-cli/validate"
 )
 
@@ -33,45 +32,37 @@ Block storage offers a method to attach additional storage to Cloud Server.
 Once attached, volumes appear as normal block devices, and can be used as such.
 `,
 	Run: func(cmd *cobra.Command, args []string) {
-		uniqIdFlag, _ := cmd.Flags().GetString("uniq-id")
 		newSizeFlag, _ := cmd.Flags().GetInt64("new-size")
 
-		validateFields := map[interface{}]interface{}{
-			uniqIdFlag:  "UniqId",
-			newSizeFlag: "PositiveInt64",
-		}
-		if err := validate.Validate(validateFields); err != nil {
-			lwCliInst.Die(err)
-		}
+		runTargets(requireTargets(cmd), func(uniqId string) error {
+			validateFields := map[interface{}]interface{}{
+				uniqId:      "UniqId",
+				newSizeFlag: "PositiveInt64",
+			}
+			if err := validate.Validate(validateFields); err != nil {
+				return err
+			}
 
-		apiArgs := map[string]interface{}{
-			"uniq_id":  uniqIdFlag,
-			"new_size": newSizeFlag,
-		}
+			details, err := lwCliInst.Api.Storage.Block.Volume.Resize(lwCliInst.Context(), uniqId, newSizeFlag)
+			if err != nil {
+				return err
+			}
 
-		var details apiTypes.CloudBlockStorageVolumeResize
-		err := lwCliInst.CallLwApiInto("bleed/storage/block/volume/resize",
-			apiArgs, &details)
-		if err != nil {
-			lwCliInst.Die(err)
-		}
+			fmt.Printf("Resized Block Storage Volume [%s] from size [%d] to [%d] GB\n",
+				details.UniqId, details.OldSize, details.NewSize)
 
-		fmt.Printf("Resized Block Storage Volume [%s] from size [%d] to [%d] GB\n",
-			details.UniqId, details.OldSize, details.NewSize)
+			return nil
+		})
 	},
 }
 
 func init() {
 	cloudStorageBlockVolumeCmd.AddCommand(cloudStorageBlockVolumeResizeCmd)
 
-	cloudStorageBlockVolumeResizeCmd.Flags().String("uniq-id", "",
-		"uniq-id of Cloud Block Storage Volume")
+	addTargetFlags(cloudStorageBlockVolumeResizeCmd, "uniq-id of Cloud Block Storage Volume")
 	cloudStorageBlockVolumeResizeCmd.Flags().Int64("new-size", -1,
 		"size (gb) to resize the volume to")
 
-	if err := cloudStorageBlockVolumeResizeCmd.MarkFlagRequired("uniq-id"); err != nil {
-		lwCliInst.Die(err)
-	}
 	if err := cloudStorageBlockVolumeResizeCmd.MarkFlagRequired("new-size"); err != nil {
 		lwCliInst.Die(err)
 	}
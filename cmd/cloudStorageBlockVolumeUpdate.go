@@ -20,7 +20,7 @@ import (
 
 	"github.com/spf13/cobra"
 
-	"github.com/liquidweb/liquidweb-cli/types/api"
+	lwClient "github.com/liquidweb/liquidweb-cli/client"
 	"github.com/liquidweb/liquidweb-cli/validate"
 )
 
@@ -33,7 +33,7 @@ Block storage offers a method to attach additional storage to Cloud Server.
 Once attached, volumes appear as normal block devices, and can be used as such.
 `,
 	Run: func(cmd *cobra.Command, args []string) {
-		uniqIdFlag, _ := cmd.Flags().GetString("uniq-id")
+		uniqIdFlag := singleTarget(cmd)
 		nameFlag, _ := cmd.Flags().GetString("name")
 		enableCrossAttachFlag, _ := cmd.Flags().GetBool("enable-cross-attach")
 		disableCrossAttachFlag, _ := cmd.Flags().GetBool("disable-cross-attach")
@@ -49,23 +49,19 @@ Once attached, volumes appear as normal block devices, and can be used as such.
 			lwCliInst.Die(fmt.Errorf("cant both enable and disable"))
 		}
 
-		apiArgs := map[string]interface{}{
-			"uniq_id": uniqIdFlag,
+		updateReq := &lwClient.StorageBlockVolumeUpdateRequest{
+			UniqId: uniqIdFlag,
+			Domain: nameFlag,
 		}
-
 		if enableCrossAttachFlag {
-			apiArgs["cross_attach"] = true
+			crossAttach := true
+			updateReq.CrossAttach = &crossAttach
 		} else if disableCrossAttachFlag {
-			apiArgs["cross_attach"] = false
-		}
-
-		if nameFlag != "" {
-			apiArgs["domain"] = nameFlag
+			crossAttach := false
+			updateReq.CrossAttach = &crossAttach
 		}
 
-		var details apiTypes.CloudBlockStorageVolumeDetails
-		err := lwCliInst.CallLwApiInto("bleed/storage/block/volume/update",
-			apiArgs, &details)
+		details, err := lwCliInst.Api.Storage.Block.Volume.Update(lwCliInst.Context(), updateReq)
 		if err != nil {
 			lwCliInst.Die(err)
 		}
@@ -77,16 +73,11 @@ Once attached, volumes appear as normal block devices, and can be used as such.
 func init() {
 	cloudStorageBlockVolumeCmd.AddCommand(cloudStorageBlockVolumeUpdateCmd)
 
-	cloudStorageBlockVolumeUpdateCmd.Flags().String("uniq-id", "",
-		"uniq-id of Cloud Block Storage Volume")
+	addSingleTargetFlags(cloudStorageBlockVolumeUpdateCmd, "uniq-id of Cloud Block Storage Volume")
 	cloudStorageBlockVolumeUpdateCmd.Flags().String("name", "",
 		"new name for the Cloud Block Storage Volume")
 	cloudStorageBlockVolumeUpdateCmd.Flags().Bool("enable-cross-attach", false,
 		"enable cross attach for Cloud Block Storage Volume")
 	cloudStorageBlockVolumeUpdateCmd.Flags().Bool("disable-cross-attach", false,
 		"disable cross attach for Cloud Block Storage Volume")
-
-	if err := cloudStorageBlockVolumeUpdateCmd.MarkFlagRequired("uniq-id"); err != nil {
-		lwCliInst.Die(err)
-	}
 }
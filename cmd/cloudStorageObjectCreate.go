@@ -16,11 +16,7 @@ limitations under the License.
 package cmd
 
 import (
-	"fmt"
-
 	"github.com/spf13/cobra"
-
-	"github.com/liquidweb/liquidweb-cli/types/api"
 )
 
 var cloudStorageObjectCreateCmd = &cobra.Command{
@@ -28,13 +24,12 @@ var cloudStorageObjectCreateCmd = &cobra.Command{
 	Short: "Create a Object Store",
 	Long:  `Create a Object Store`,
 	Run: func(cmd *cobra.Command, args []string) {
-		var details apiTypes.CloudObjectStoreDetails
-		err := lwCliInst.CallLwApiInto("bleed/storage/objectstore/create", map[string]interface{}{}, &details)
+		details, err := lwCliInst.Api.Storage.ObjectStore.Create(lwCliInst.Context())
 		if err != nil {
 			lwCliInst.Die(err)
 		}
 
-		fmt.Print(details)
+		printResult(cmd, details)
 	},
 }
 
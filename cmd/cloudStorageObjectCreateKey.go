@@ -20,7 +20,7 @@ import (
 
 	"github.com/spf13/cobra"
 
-	"github.com/liquidweb/liquidweb-cli/types/api"
+	"github.com/liquidweb/liquidweb-cli/output"
 	"github.com/liquidweb/liquidweb-cli/validate"
 )
 
@@ -29,37 +29,35 @@ var cloudStorageObjectCreateKeyCmd = &cobra.Command{
 	Short: "Create a new key for the Object Store",
 	Long:  `Create a new key for the Object Store`,
 	Run: func(cmd *cobra.Command, args []string) {
-		uniqIdFlag, _ := cmd.Flags().GetString("uniq-id")
-
-		validateFields := map[interface{}]interface{}{
-			uniqIdFlag: "UniqId",
-		}
-		if err := validate.Validate(validateFields); err != nil {
-			lwCliInst.Die(err)
-		}
-
-		apiArgs := map[string]interface{}{
-			"uniq_id": uniqIdFlag,
-		}
-
-		var details apiTypes.CloudObjectStoreKeyDetails
-		err := lwCliInst.CallLwApiInto("bleed/storage/objectstore/createkey", apiArgs, &details)
-		if err != nil {
-			lwCliInst.Die(err)
-		}
-
-		fmt.Printf("Created Key:\n")
-		fmt.Printf("\tUser: %s\n", details.User)
-		fmt.Printf("\tAccess Key: %s\n", details.AccessKey)
-		fmt.Printf("\tSecret Key: %s\n", details.SecretKey)
+		runTargets(requireTargets(cmd), func(uniqId string) error {
+			validateFields := map[interface{}]interface{}{
+				uniqId: "UniqId",
+			}
+			if err := validate.Validate(validateFields); err != nil {
+				return err
+			}
+
+			details, err := lwCliInst.Api.Storage.ObjectStore.CreateKey(lwCliInst.Context(), uniqId)
+			if err != nil {
+				return err
+			}
+
+			if cmdOutputFormat(cmd) != output.Text {
+				printResult(cmd, details)
+				return nil
+			}
+
+			fmt.Printf("Created Key for Object Store [%s]:\n", uniqId)
+			fmt.Printf("\tUser: %s\n", details.User)
+			fmt.Printf("\tAccess Key: %s\n", details.AccessKey)
+			fmt.Printf("\tSecret Key: %s\n", details.SecretKey)
+
+			return nil
+		})
 	},
 }
 
 func init() {
 	cloudStorageObjectCmd.AddCommand(cloudStorageObjectCreateKeyCmd)
-	cloudStorageObjectCreateKeyCmd.Flags().String("uniq-id", "", "uniq-id of Object Store")
-
-	if err := cloudStorageObjectCreateKeyCmd.MarkFlagRequired("uniq-id"); err != nil {
-		lwCliInst.Die(err)
-	}
+	addTargetFlags(cloudStorageObjectCreateKeyCmd, "uniq-id of Object Store")
 }
@@ -21,7 +21,6 @@ import (
 
 	"github.com/spf13/cobra"
 
-	"github.com/liquidweb/liquidweb-cli/types/api"
 	"github.com/liquidweb/liquidweb-cli/validate"
 )
 
@@ -30,7 +29,7 @@ var cloudStorageObjectDeleteCmd = &cobra.Command{
 	Short: "Delete an Object Store",
 	Long:  `Delete an Object Store`,
 	Run: func(cmd *cobra.Command, args []string) {
-		uniqIdFlag, _ := cmd.Flags().GetString("uniq-id")
+		targets := requireTargets(cmd)
 		forceFlag, _ := cmd.Flags().GetBool("force")
 
 		// if force flag wasn't passed
@@ -41,32 +40,28 @@ var cloudStorageObjectDeleteCmd = &cobra.Command{
 			}
 		}
 
-		validateFields := map[interface{}]interface{}{
-			uniqIdFlag: "UniqId",
-		}
-		if err := validate.Validate(validateFields); err != nil {
-			lwCliInst.Die(err)
-		}
+		runTargets(targets, func(uniqId string) error {
+			validateFields := map[interface{}]interface{}{
+				uniqId: "UniqId",
+			}
+			if err := validate.Validate(validateFields); err != nil {
+				return err
+			}
 
-		apiArgs := map[string]interface{}{"uniq_id": uniqIdFlag}
+			details, err := lwCliInst.Api.Storage.ObjectStore.Delete(lwCliInst.Context(), uniqId)
+			if err != nil {
+				return err
+			}
 
-		var details apiTypes.CloudObjectStoreDelete
-		err := lwCliInst.CallLwApiInto("bleed/storage/objectstore/delete", apiArgs, &details)
-		if err != nil {
-			lwCliInst.Die(err)
-		}
+			fmt.Printf("deleted object store %s\n", details.Deleted)
 
-		fmt.Printf("deleted object store %s\n", details.Deleted)
+			return nil
+		})
 	},
 }
 
 func init() {
 	cloudStorageObjectCmd.AddCommand(cloudStorageObjectDeleteCmd)
-	cloudStorageObjectDeleteCmd.Flags().String("uniq-id", "",
-		"uniq-id of object store to delete (see 'cloud storage object list')")
+	addTargetFlags(cloudStorageObjectDeleteCmd, "uniq-id of object store to delete (see 'cloud storage object list')")
 	cloudStorageObjectDeleteCmd.Flags().Bool("force", false, "bypass dialog confirmation")
-
-	if err := cloudStorageObjectDeleteCmd.MarkFlagRequired("uniq-id"); err != nil {
-		lwCliInst.Die(err)
-	}
 }
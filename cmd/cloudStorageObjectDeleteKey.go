@@ -21,7 +21,6 @@ import (
 
 	"github.com/spf13/cobra"
 
-	"github.com/liquidweb/liquidweb-cli/types/api"
 	"github.com/liquidweb/liquidweb-cli/validate"
 )
 
@@ -30,7 +29,7 @@ var cloudStorageObjectDeleteKeyCmd = &cobra.Command{
 	Short: "Delete a key from the Object Store",
 	Long:  `Delete a key from the Object Store`,
 	Run: func(cmd *cobra.Command, args []string) {
-		uniqIdFlag, _ := cmd.Flags().GetString("uniq-id")
+		uniqIdFlag := singleTarget(cmd)
 		accessKeyFlag, _ := cmd.Flags().GetString("access-key")
 		forceFlag, _ := cmd.Flags().GetBool("force")
 
@@ -50,13 +49,7 @@ var cloudStorageObjectDeleteKeyCmd = &cobra.Command{
 			lwCliInst.Die(err)
 		}
 
-		apiArgs := map[string]interface{}{
-			"uniq_id":    uniqIdFlag,
-			"access_key": accessKeyFlag,
-		}
-
-		var details apiTypes.CloudObjectStoreDeleteKey
-		err := lwCliInst.CallLwApiInto("bleed/storage/objectstore/deletekey", apiArgs, &details)
+		details, err := lwCliInst.Api.Storage.ObjectStore.DeleteKey(lwCliInst.Context(), uniqIdFlag, accessKeyFlag)
 		if err != nil {
 			lwCliInst.Die(err)
 		}
@@ -67,13 +60,10 @@ var cloudStorageObjectDeleteKeyCmd = &cobra.Command{
 
 func init() {
 	cloudStorageObjectCmd.AddCommand(cloudStorageObjectDeleteKeyCmd)
-	cloudStorageObjectDeleteKeyCmd.Flags().String("uniq-id", "", "uniq-id of Object Store")
+	addSingleTargetFlags(cloudStorageObjectDeleteKeyCmd, "uniq-id of Object Store")
 	cloudStorageObjectDeleteKeyCmd.Flags().String("access-key", "", "the access key to remove from the Object Store")
 	cloudStorageObjectDeleteKeyCmd.Flags().Bool("force", false, "bypass dialog confirmation")
 
-	if err := cloudStorageObjectDeleteKeyCmd.MarkFlagRequired("uniq-id"); err != nil {
-		lwCliInst.Die(err)
-	}
 	if err := cloudStorageObjectDeleteKeyCmd.MarkFlagRequired("access-key"); err != nil {
 		lwCliInst.Die(err)
 	}
@@ -16,11 +16,8 @@ limitations under the License.
 package cmd
 
 import (
-	"fmt"
-
 	"github.com/spf13/cobra"
 
-	"github.com/liquidweb/liquidweb-cli/types/api"
 	"github.com/liquidweb/liquidweb-cli/validate"
 )
 
@@ -29,32 +26,27 @@ var cloudStorageObjectDetailsCmd = &cobra.Command{
 	Short: "Get details of a Object Store",
 	Long:  `Get details of a Object Store`,
 	Run: func(cmd *cobra.Command, args []string) {
-		uniqIdFlag, _ := cmd.Flags().GetString("uniq-id")
-
-		validateFields := map[interface{}]interface{}{
-			uniqIdFlag: "UniqId",
-		}
-		if err := validate.Validate(validateFields); err != nil {
-			lwCliInst.Die(err)
-		}
-
-		var details apiTypes.CloudObjectStoreDetails
-		apiArgs := map[string]interface{}{
-			"uniq_id": uniqIdFlag,
-		}
-		if err := lwCliInst.CallLwApiInto("bleed/storage/objectstore/details", apiArgs, &details); err != nil {
-			lwCliInst.Die(err)
-		}
-
-		fmt.Print(details)
+		runTargets(requireTargets(cmd), func(uniqId string) error {
+			validateFields := map[interface{}]interface{}{
+				uniqId: "UniqId",
+			}
+			if err := validate.Validate(validateFields); err != nil {
+				return err
+			}
+
+			details, err := lwCliInst.Api.Storage.ObjectStore.Details(lwCliInst.Context(), uniqId)
+			if err != nil {
+				return err
+			}
+
+			printResult(cmd, details)
+
+			return nil
+		})
 	},
 }
 
 func init() {
 	cloudStorageObjectCmd.AddCommand(cloudStorageObjectDetailsCmd)
-	cloudStorageObjectDetailsCmd.Flags().String("uniq-id", "", "uniq-id of the object store")
-
-	if err := cloudStorageObjectDetailsCmd.MarkFlagRequired("uniq-id"); err != nil {
-		lwCliInst.Die(err)
-	}
+	addTargetFlags(cloudStorageObjectDetailsCmd, "uniq-id of the object store")
 }
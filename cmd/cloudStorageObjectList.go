@@ -16,12 +16,10 @@ limitations under the License.
 package cmd
 
 import (
-	"fmt"
-
-	"github.com/spf13/cast"
 	"github.com/spf13/cobra"
 
-	"github.com/liquidweb/liquidweb-cli/instance"
+	lwClient "github.com/liquidweb/liquidweb-cli/client"
+	"github.com/liquidweb/liquidweb-cli/output"
 	"github.com/liquidweb/liquidweb-cli/types/api"
 )
 
@@ -30,35 +28,37 @@ var cloudStorageObjectListCmd = &cobra.Command{
 	Short: "List Object Stores on your account",
 	Long:  `List Object Stores on your account`,
 	Run: func(cmd *cobra.Command, args []string) {
-		methodArgs := instance.AllPaginatedResultsArgs{
-			Method: "bleed/asset/list",
-			MethodArgs: map[string]interface{}{
-				"type": "SS.ObjectStore",
-			},
-			ResultsPerPage: 100,
+		listQuery := newListQuery(cmd)
+		listOpts := lwClient.ListOptions{
+			PageSize: 100,
+			Limit:    listQuery.ListLimit(),
 		}
-		results, err := lwCliInst.AllPaginatedResults(&methodArgs)
+
+		printer := newListPrinter(cmd)
+		err := listQuery.Run(func(visit output.ItemFunc) error {
+			return lwCliInst.Api.Storage.ObjectStore.Each(lwCliInst.Context(), listOpts, func(item *apiTypes.Subaccnt) (bool, error) {
+				details, err := lwCliInst.Api.Storage.ObjectStore.Details(lwCliInst.Context(), item.UniqId)
+				if err != nil {
+					return false, err
+				}
+
+				return visit(details)
+			})
+		}, func(item interface{}) (bool, error) {
+			return true, printer.Add(item)
+		})
 		if err != nil {
 			lwCliInst.Die(err)
 		}
-
-		for _, item := range results.Items {
-
-			itemUniqIdStr := cast.ToString(item["uniq_id"])
-
-			var details apiTypes.CloudObjectStoreDetails
-			apiArgs := map[string]interface{}{
-				"uniq_id": itemUniqIdStr,
-			}
-			if err := lwCliInst.CallLwApiInto("bleed/storage/objectstore/details", apiArgs, &details); err != nil {
-				lwCliInst.Die(err)
-			}
-
-			fmt.Print(details)
+		if err := printer.Flush(); err != nil {
+			lwCliInst.Die(err)
 		}
 	},
 }
 
 func init() {
 	cloudStorageObjectCmd.AddCommand(cloudStorageObjectListCmd)
+
+	cloudStorageObjectListCmd.Flags().Int64("limit", 0, "stop after listing this many Object Stores (0 for no limit)")
+	addListQueryFlags(cloudStorageObjectListCmd)
 }
@@ -22,7 +22,9 @@ import (
 	"github.com/spf13/cast"
 	"github.com/spf13/cobra"
 
-	"github.com/liquidweb/liquidweb-cli/instance"
+	lwClient "github.com/liquidweb/liquidweb-cli/client"
+	"github.com/liquidweb/liquidweb-cli/output"
+	"github.com/liquidweb/liquidweb-cli/types/api"
 )
 
 var cloudTemplateListCmd = &cobra.Command{
@@ -34,83 +36,86 @@ var cloudTemplateListCmd = &cobra.Command{
 		filterOsFlag, _ := cmd.Flags().GetString("os")
 		filterManageLevelFlag, _ := cmd.Flags().GetString("manage-level")
 
-		templateList, err := lwCliInst.AllPaginatedResults(&instance.AllPaginatedResultsArgs{
-			Method:         "bleed/storm/template/list",
-			ResultsPerPage: 100,
-		})
-		if err != nil {
-			lwCliInst.Die(err)
-		}
-
-		zonesResults, err := lwCliInst.LwCliApiClient.Call("bleed/network/zone/list", nil)
-		if err != nil {
-			lwCliInst.Die(err)
-		}
-
 		type ZoneInfo struct {
 			Id         int
 			Name       string
 			RegionName string
 		}
 		zones := make(map[int]*ZoneInfo)
-		zoneMap := zonesResults.(map[string]interface{})
 
-		for _, item := range zoneMap["items"].([]interface{}) {
-			z := item.(map[string]interface{})
+		err := lwCliInst.Api.Network.Zone.Each(lwCliInst.Context(), lwClient.ListOptions{PageSize: 100},
+			func(z *apiTypes.NetworkZoneDetails) (bool, error) {
+				zone := &ZoneInfo{
+					Id:         int(z.Id),
+					Name:       z.Name,
+					RegionName: z.Region.Name,
+				}
+				zones[zone.Id] = zone
 
-			zone := &ZoneInfo{
-				Id:         cast.ToInt(z["id"]),
-				Name:       cast.ToString(z["name"]),
-				RegionName: cast.ToString(z["region"].(map[string]interface{})["name"]),
-			}
-			zones[zone.Id] = zone
+				return true, nil
+			})
+		if err != nil {
+			lwCliInst.Die(err)
 		}
 
-		for _, template := range templateList.Items {
-			if cast.ToBool(cast.ToInt(template["deprecated"])) {
-				continue
-			}
+		printer := newListPrinter(cmd)
+		err = lwCliInst.Api.Storm.Template.Each(lwCliInst.Context(), lwClient.ListOptions{PageSize: 100},
+			func(template *apiTypes.CloudTemplateDetails) (bool, error) {
+				if template.Deprecated != 0 {
+					return true, nil
+				}
 
-			if !strings.HasPrefix(strings.ToLower(cast.ToString(template["os"])), strings.ToLower(filterOsFlag)) {
-				continue
-			}
+				if !strings.HasPrefix(strings.ToLower(template.Os), strings.ToLower(filterOsFlag)) {
+					return true, nil
+				}
 
-			if filterManageLevelFlag != "" {
-				if strings.ToLower(filterManageLevelFlag) != strings.ToLower(cast.ToString(template["manage_level"])) {
-					continue
+				if filterManageLevelFlag != "" {
+					if strings.ToLower(filterManageLevelFlag) != strings.ToLower(template.ManageLevel) {
+						return true, nil
+					}
 				}
-			}
 
-			if zoneFlag != -1 {
-				var skip bool = true
+				if zoneFlag != -1 {
+					var skip bool = true
 
-				for templateZoneStr, _ := range template["zone_availability"].(map[string]interface{}) {
-					templateZone := cast.ToInt(templateZoneStr)
-					if templateZone == zoneFlag {
-						skip = false
+					for templateZoneStr := range template.ZoneAvailability {
+						templateZone := cast.ToInt(templateZoneStr)
+						if templateZone == zoneFlag {
+							skip = false
+						}
+					}
+
+					if skip {
+						return true, nil
 					}
 				}
 
-				if skip {
-					continue
+				if printer.Format != output.Text {
+					return true, printer.Add(template)
 				}
-			}
-
-			fmt.Println("name:", template["name"])
-			fmt.Println("  description: ", template["description"])
-			fmt.Print("  os: ", template["os"])
-			fmt.Println(", manage-level:", template["manage_level"])
-			fmt.Println("  Zone Availibility:")
-
-			for templateZoneStr, _ := range template["zone_availability"].(map[string]interface{}) {
-				templateZone := cast.ToInt(templateZoneStr)
-				if z, ok := zones[templateZone]; ok {
-					fmt.Printf("    %5d - %s - %s\n", z.Id, z.Name, z.RegionName)
+
+				fmt.Println("name:", template.Name)
+				fmt.Println("  description: ", template.Description)
+				fmt.Print("  os: ", template.Os)
+				fmt.Println(", manage-level:", template.ManageLevel)
+				fmt.Println("  Zone Availibility:")
+
+				for templateZoneStr := range template.ZoneAvailability {
+					templateZone := cast.ToInt(templateZoneStr)
+					if z, ok := zones[templateZone]; ok {
+						fmt.Printf("    %5d - %s - %s\n", z.Id, z.Name, z.RegionName)
+					}
 				}
 
-			}
+				fmt.Println("")
 
-			fmt.Println("")
+				return true, nil
+			})
+		if err != nil {
+			lwCliInst.Die(err)
+		}
+		if err := printer.Flush(); err != nil {
+			lwCliInst.Die(err)
 		}
 	},
 }
@@ -18,7 +18,6 @@ package cmd
 import (
 	"fmt"
 
-	"github.com/spf13/cast"
 	"github.com/spf13/cobra"
 
 	"github.com/liquidweb/liquidweb-cli/instance"
@@ -41,28 +40,28 @@ cloud:
 lw plan --file cloud.template.restore.yaml
 `,
 	Run: func(cmd *cobra.Command, args []string) {
-		params := &instance.CloudTemplateRestoreParams{}
+		runTargets(requireTargets(cmd), func(uniqId string) error {
+			params := &instance.CloudTemplateRestoreParams{}
 
-		params.UniqId, _ = cmd.Flags().GetString("uniq-id")
-		params.Template, _ = cmd.Flags().GetString("template")
+			params.UniqId = uniqId
+			params.Template, _ = cmd.Flags().GetString("template")
 
-		result, err := lwCliInst.CloudTemplateRestore(params)
-		if err != nil {
-			lwCliInst.Die(err)
-		}
+			result, err := lwCliInst.CloudTemplateRestore(params)
+			if err != nil {
+				return err
+			}
 
-		fmt.Printf("Restoring template! %s\n", result)
-		fmt.Printf("\tcheck progress with 'cloud server status --uniq-id %s'\n", params.UniqId)
+			fmt.Printf("Restoring template! %s\n", result)
+			fmt.Printf("\tcheck progress with 'cloud server status --uniq-id %s'\n", params.UniqId)
+
+			return nil
+		})
 	},
 }
 
 func init() {
 	cloudTemplateCmd.AddCommand(cloudTemplateRestoreCmd)
 
-	cloudTemplateRestoreCmd.Flags().String("uniq-id", "", "uniq-id of Cloud Server")
-	cloudTemplateRestoreCmd.Flags().String("template", cast.ToString(defaultFlag("cloud_template_restore_template")), "name of template to restore")
-
-	if err := cloudTemplateRestoreCmd.MarkFlagRequired("uniq-id"); err != nil {
-		lwCliInst.Die(err)
-	}
+	addTargetFlags(cloudTemplateRestoreCmd, "uniq-id of Cloud Server")
+	cloudTemplateRestoreCmd.Flags().String("template", "", "name of template to restore")
 }
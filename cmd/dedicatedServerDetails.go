@@ -20,36 +20,26 @@ import (
 
 	"github.com/spf13/cobra"
 
-	"github.com/liquidweb/liquidweb-cli/types/api"
 	"github.com/liquidweb/liquidweb-cli/validate"
 )
 
-var dedicatedServerDetailsCmdUniqIdFlag []string
-
 var dedicatedServerDetailsCmd = &cobra.Command{
 	Use:   "details",
 	Short: "Get details of a dedicated server",
 	Long:  `Get details of a dedicated server`,
 	Run: func(cmd *cobra.Command, args []string) {
-		jsonFlag, _ := cmd.Flags().GetBool("json")
-
-		for _, uniqId := range dedicatedServerDetailsCmdUniqIdFlag {
+		printer := newPrinter(cmd)
+		runPrintedTargets(printer, requireTargets(cmd), func(uniqId string) error {
 			validateFields := map[interface{}]interface{}{
 				uniqId: "UniqId",
 			}
 			if err := validate.Validate(validateFields); err != nil {
-				fmt.Printf("%s ... skipping\n", err)
-				continue
-			}
-
-			var details apiTypes.Subaccnt
-			apiArgs := map[string]interface{}{
-				"uniq_id":  uniqId,
-				"alsowith": []string{"categories"},
+				return err
 			}
 
-			if err := lwCliInst.CallLwApiInto("bleed/asset/details", apiArgs, &details); err != nil {
-				lwCliInst.Die(err)
+			details, err := lwCliInst.Api.Asset.Details(lwCliInst.Context(), uniqId, "categories")
+			if err != nil {
+				return err
 			}
 
 			var found bool
@@ -61,30 +51,17 @@ var dedicatedServerDetailsCmd = &cobra.Command{
 			}
 
 			if !found {
-				lwCliInst.Die(fmt.Errorf("UniqId [%s] is not a dedicated server", uniqId))
+				return fmt.Errorf("UniqId [%s] is not a dedicated server", uniqId)
 			}
 
-			if jsonFlag {
-				pretty, err := lwCliInst.JsonEncodeAndPrettyPrint(details)
-				if err != nil {
-					panic(err)
-				}
-				fmt.Print(pretty)
-			} else {
-				fmt.Print(details)
-			}
-		}
+			return printer.Print(details)
+		})
 	},
 }
 
 func init() {
 	dedicatedServerCmd.AddCommand(dedicatedServerDetailsCmd)
 
-	dedicatedServerDetailsCmd.Flags().Bool("json", false, "output in json format")
-	dedicatedServerDetailsCmd.Flags().StringSliceVar(&dedicatedServerDetailsCmdUniqIdFlag, "uniq-id", []string{},
-		"uniq-id of the dedicated server. For multiple, must be ',' separated")
-
-	if err := dedicatedServerDetailsCmd.MarkFlagRequired("uniq-id"); err != nil {
-		lwCliInst.Die(err)
-	}
+	dedicatedServerDetailsCmd.Flags().Bool("json", false, "output in json format (same as --output json)")
+	addTargetFlags(dedicatedServerDetailsCmd, "uniq-id of the dedicated server")
 }
@@ -20,7 +20,8 @@ import (
 
 	"github.com/spf13/cobra"
 
-	"github.com/liquidweb/liquidweb-cli/instance"
+	lwClient "github.com/liquidweb/liquidweb-cli/client"
+	"github.com/liquidweb/liquidweb-cli/output"
 	"github.com/liquidweb/liquidweb-cli/types/api"
 )
 
@@ -29,39 +30,53 @@ var dedicatedServerListCmd = &cobra.Command{
 	Short: "List Dedicated Servers on your account",
 	Long:  `List Dedicated Servers on your account`,
 	Run: func(cmd *cobra.Command, args []string) {
-		jsonFlag, _ := cmd.Flags().GetBool("json")
+		listQuery := newListQuery(cmd)
 
-		methodArgs := instance.AllPaginatedResultsArgs{
-			Method:         "bleed/asset/list",
-			ResultsPerPage: 100,
-			MethodArgs: map[string]interface{}{
-				"category": []string{"StrictDedicated"},
+		listReq := &lwClient.AssetListRequest{
+			ListOptions: lwClient.ListOptions{
+				PageSize: 100,
+				Limit:    listQuery.ListLimit(),
 			},
-		}
-		results, err := lwCliInst.AllPaginatedResults(&methodArgs)
-		if err != nil {
-			lwCliInst.Die(err)
+			Category: []string{"StrictDedicated"},
 		}
 
-		if jsonFlag {
-			pretty, err := lwCliInst.JsonEncodeAndPrettyPrint(results)
+		printer := newListPrinter(cmd)
+		if printer.Format.Encoded() {
+			results, err := lwCliInst.Api.Asset.All(lwCliInst.Context(), listReq)
 			if err != nil {
 				lwCliInst.Die(err)
 			}
-			fmt.Printf(pretty)
-		} else {
-			serverCnt := 1
-			for _, item := range results.Items {
-
-				var details apiTypes.Subaccnt
-				if err := instance.CastFieldTypes(item, &details); err != nil {
-					lwCliInst.Die(err)
-				}
+			if results.Items, err = listQuery.Items(results.Items); err != nil {
+				lwCliInst.Die(err)
+			}
+			if err := printer.Print(results); err != nil {
+				lwCliInst.Die(err)
+			}
+			return
+		}
 
-				fmt.Printf("%d.) ", serverCnt)
-				fmt.Print(details)
-				serverCnt++
+		serverCnt := 1
+		err := listQuery.Run(func(visit output.ItemFunc) error {
+			return lwCliInst.Api.Asset.Each(lwCliInst.Context(), listReq, func(details *apiTypes.Subaccnt) (bool, error) {
+				return visit(details)
+			})
+		}, func(item interface{}) (bool, error) {
+			details := item.(*apiTypes.Subaccnt)
+			if printer.Format != output.Text {
+				return true, printer.Add(details)
 			}
+
+			fmt.Printf("%d.) ", serverCnt)
+			fmt.Print(details)
+			serverCnt++
+
+			return true, nil
+		})
+		if err != nil {
+			lwCliInst.Die(err)
+		}
+		if err := printer.Flush(); err != nil {
+			lwCliInst.Die(err)
 		}
 	},
 }
@@ -69,5 +84,7 @@ var dedicatedServerListCmd = &cobra.Command{
 func init() {
 	dedicatedServerCmd.AddCommand(dedicatedServerListCmd)
 
-	dedicatedServerListCmd.Flags().Bool("json", false, "output in json format")
+	dedicatedServerListCmd.Flags().Bool("json", false, "output in json format (same as --output json)")
+	dedicatedServerListCmd.Flags().Int64("limit", 0, "stop after listing this many Dedicated Servers (0 for no limit)")
+	addListQueryFlags(dedicatedServerListCmd)
 }
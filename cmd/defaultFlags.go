@@ -16,9 +16,13 @@ limitations under the License.
 package cmd
 
 import (
+	"fmt"
 	"os"
 
 	"github.com/spf13/cobra"
+
+	"github.com/liquidweb/liquidweb-cli/flags/defaults"
+	"github.com/liquidweb/liquidweb-cli/instance"
 )
 
 var defaultFlagsCmd = &cobra.Command{
@@ -30,6 +34,33 @@ If a default flag is set (such as for "zone") then any subcommand will use its
 value in place if omitted. Default flags are auth context aware. For details
 on auth contexts, see 'help auth'.
 
+Defaults can be set for nearly every command flag, named after the command and
+the flag joined by "_" (cloud_server_create_zone for the --zone flag of 'cloud
+server create'). Flags picking what a command acts on, such as --uniq-id or
+--targets-file, --force, and flags taking passwords, private keys or tokens
+can't have defaults. See 'default-flags permitted' for the full list. A flag passed
+on the command line, or set through its environment variable (see 'help'), wins
+over its default.
+
+Default flags are looked up in layers, the first setting a flag winning:
+
+  1. the project file's section for the current context
+  2. the project file's flags for every context
+  3. the current context's flags in ~/.liquidweb-cli-flag-defaults.yaml
+
+The project file is the .lw-defaults.yaml nearest the working directory, found by
+walking up from it. It can only set flags picking where and what to create (such
+as --zone, --config-id or --template) and the output flags of list commands, and
+commands say when they take defaults from it. It looks like:
+
+  defaults:
+    cloud_server_create_zone: 27
+  contexts:
+    prod:
+      cloud_server_create_zone: 40
+
+See 'default-flags list --resolved' for the flags applying, and where each came from.
+
 For a full list of capabilities, please refer to the "Available Commands" section.`,
 	Run: func(cmd *cobra.Command, args []string) {
 		if err := cmd.Help(); err != nil {
@@ -42,3 +73,43 @@ For a full list of capabilities, please refer to the "Available Commands" sectio
 func init() {
 	rootCmd.AddCommand(defaultFlagsCmd)
 }
+
+// addDefaultFlagsScopeFlags adds the flags picking which layer of default flags a
+// subcommand works on.
+func addDefaultFlagsScopeFlags(cmd *cobra.Command) {
+	cmd.Flags().String("context", "",
+		"auth context the flag applies to (default the current one, or every one with --project)")
+	cmd.Flags().Bool("project", false, fmt.Sprintf("use the project file (%s) instead of your own", defaults.ProjectFileName))
+}
+
+// defaultFlagsScope returns the layer of default flags picked by the flags added by
+// addDefaultFlagsScopeFlags.
+func defaultFlagsScope(cmd *cobra.Command) defaults.Scope {
+	var scope defaults.Scope
+	scope.Context, _ = cmd.Flags().GetString("context")
+	scope.Project, _ = cmd.Flags().GetBool("project")
+
+	// project files travel between machines, so their contexts needn't exist here
+	if scope.Context != "" && !scope.Project {
+		if err := instance.ValidateContext(scope.Context, lwCliInst.Viper); err != nil {
+			lwCliInst.Die(err)
+		}
+	}
+
+	return scope
+}
+
+// describeDefaultFlagsScope returns the layer of default flags scope refers to, for
+// messages.
+func describeDefaultFlagsScope(scope defaults.Scope) string {
+	switch {
+	case scope.Project && scope.Context != "":
+		return fmt.Sprintf("the project file, context [%s]", scope.Context)
+	case scope.Project:
+		return "the project file, every context"
+	case scope.Context != "":
+		return fmt.Sprintf("context [%s]", scope.Context)
+	}
+
+	return "the current context"
+}
@@ -28,23 +28,29 @@ var defaultFlagsDeleteCmd = &cobra.Command{
 	Short: "Delete a flag",
 	Long: `Delete a flag for the current context.
 
+Pass --context to delete it for another auth context, and --project to delete it from
+the project file (.lw-defaults.yaml) rather than your own; see 'help default-flags'.
+
 When a default flag is set (such as "zone") then any subcommand will use its
 value in place if omitted. Default flags are auth context aware. For details
 on auth contexts, see 'help auth'.`,
 	Run: func(cmd *cobra.Command, args []string) {
 		flagName, _ := cmd.Flags().GetString("flag")
 
-		if err := defaults.Delete(flagName); err != nil {
+		scope := defaultFlagsScope(cmd)
+
+		if err := defaults.Delete(scope, flagName); err != nil {
 			lwCliInst.Die(err)
 		}
 
-		fmt.Printf("deleted default flag [%s]\n", flagName)
+		fmt.Printf("deleted default flag [%s] from %s\n", flagName, describeDefaultFlagsScope(scope))
 	},
 }
 
 func init() {
 	defaultFlagsCmd.AddCommand(defaultFlagsDeleteCmd)
 	defaultFlagsDeleteCmd.Flags().String("flag", "", "name of the flag to delete")
+	addDefaultFlagsScopeFlags(defaultFlagsDeleteCmd)
 	if err := defaultFlagsDeleteCmd.MarkFlagRequired("flag"); err != nil {
 		lwCliInst.Die(err)
 	}
@@ -28,6 +28,9 @@ var defaultFlagsGetCmd = &cobra.Command{
 	Short: "Get details on a flag",
 	Long: `Get details on a flag in the current context.
 
+The value shown is the one commands use, taken from the most specific layer setting
+it; see 'help default-flags'.
+
 When a default flag is set (such as "zone") then any subcommand will use its
 value in place if omitted. Default flags are auth context aware. For details
 on auth contexts, see 'help auth'.`,
@@ -41,6 +44,17 @@ on auth contexts, see 'help auth'.`,
 
 		fmt.Printf("flag: %s\n", flagName)
 		fmt.Printf("\tvalue: %+v\n", value)
+
+		// Get has already validated the flag and found it, so Resolve will too
+		resolved, err := defaults.Resolve("")
+		if err != nil {
+			lwCliInst.Die(err)
+		}
+		for _, r := range resolved {
+			if r.Flag == flagName && r.Ignored == "" {
+				fmt.Printf("\tfrom: %s\n", r.Source)
+			}
+		}
 	},
 }
 
@@ -21,6 +21,7 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/liquidweb/liquidweb-cli/flags/defaults"
+	"github.com/liquidweb/liquidweb-cli/types/errors"
 )
 
 var defaultFlagsListCmd = &cobra.Command{
@@ -30,11 +31,31 @@ var defaultFlagsListCmd = &cobra.Command{
 
 If you've never created any default flags, see 'help default-flags set'.
 
+Lists the flags of one layer; the current context's in your own file unless --context
+or --project are given. --resolved instead lists the flags applying to the current
+context (or --context) from every layer, and which layer each came from.
+
 When a default flag is set (such as "zone") then any subcommand will use its
 value in place if omitted. Default flags are auth context aware. For details
 on auth contexts, see 'help auth'.`,
 	Run: func(cmd *cobra.Command, args []string) {
-		all, err := defaults.GetAll()
+		resolvedFlag, _ := cmd.Flags().GetBool("resolved")
+		scope := defaultFlagsScope(cmd)
+
+		if resolvedFlag {
+			if scope.Project {
+				lwCliInst.Die(fmt.Errorf("%w: --resolved covers every layer, so can't be given along with --project",
+					errorTypes.LwCliInvalidFlagValue))
+			}
+			resolved, err := defaults.Resolve(scope.Context)
+			if err != nil {
+				lwCliInst.Die(err)
+			}
+			fmt.Print(resolved)
+			return
+		}
+
+		all, err := defaults.GetAll(scope)
 		if err != nil {
 			lwCliInst.Die(err)
 		}
@@ -44,4 +65,6 @@ on auth contexts, see 'help auth'.`,
 
 func init() {
 	defaultFlagsCmd.AddCommand(defaultFlagsListCmd)
+	addDefaultFlagsScopeFlags(defaultFlagsListCmd)
+	defaultFlagsListCmd.Flags().Bool("resolved", false, "list the flags applying from every layer, and their layer")
 }
@@ -17,6 +17,7 @@ package cmd
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/spf13/cobra"
 
@@ -24,7 +25,7 @@ import (
 )
 
 var defaultFlagsPermittedCmd = &cobra.Command{
-	Use:   "permitted",
+	Use:   "permitted [COMMAND...]",
 	Short: "Display permitted default flags",
 	Long: `Display permitted default flags.
 
@@ -32,12 +33,28 @@ If you've never created any default flags, see 'help default-flags set'.
 
 When a default flag is set (such as "zone") then any subcommand will use its
 value in place if omitted. Default flags are auth context aware. For details
-on auth contexts, see 'help auth'.`,
+on auth contexts, see 'help auth'.
+
+Defaults can be set for the flags of every command, other than those of 'auth',
+'default-flags' and 'completion'; never for flags picking what a command acts on
+(such as --uniq-id or --targets-file), --force, or flags taking passwords,
+private keys or tokens. A default flag is named after the command and the flag, joined by "_";
+the --zone flag of 'cloud server create' is cloud_server_create_zone. Pass a
+command to only list its flags:
+
+  lw default-flags permitted cloud server create`,
 	Run: func(cmd *cobra.Command, args []string) {
-		permitted := defaults.GetPermitted()
+		prefix := ""
+		if len(args) > 0 {
+			prefix = strings.Join(args, "_") + "_"
+		}
+
 		fmt.Println("Permitted flags:")
-		for _, flag := range permitted {
-			fmt.Printf("  %s\n", flag)
+		for _, flag := range defaults.GetPermitted() {
+			if !strings.HasPrefix(flag, prefix) {
+				continue
+			}
+			fmt.Printf("  %s (%s)\n", flag, defaults.FlagType(flag))
 		}
 	},
 }
@@ -28,6 +28,9 @@ var defaultFlagsSetCmd = &cobra.Command{
 	Short: "Set a flag",
 	Long: `Set a flag for the current context.
 
+Pass --context to set it for another auth context, and --project to set it in the
+project file (.lw-defaults.yaml) rather than your own; see 'help default-flags'.
+
 When a default flag is set (such as "zone") then any subcommand will use its
 value in place if omitted. Default flags are auth context aware. For details
 on auth contexts, see 'help auth'.`,
@@ -35,11 +38,13 @@ on auth contexts, see 'help auth'.`,
 		flagName, _ := cmd.Flags().GetString("flag")
 		flagValue, _ := cmd.Flags().GetString("value")
 
-		if err := defaults.Set(flagName, flagValue); err != nil {
+		scope := defaultFlagsScope(cmd)
+
+		if err := defaults.Set(scope, flagName, flagValue); err != nil {
 			lwCliInst.Die(err)
 		}
 
-		fmt.Printf("flag [%s] set with value [%s]\n", flagName, flagValue)
+		fmt.Printf("flag [%s] set with value [%s] in %s\n", flagName, flagValue, describeDefaultFlagsScope(scope))
 	},
 }
 
@@ -47,6 +52,7 @@ func init() {
 	defaultFlagsCmd.AddCommand(defaultFlagsSetCmd)
 	defaultFlagsSetCmd.Flags().String("flag", "", "name of the flag to set")
 	defaultFlagsSetCmd.Flags().String("value", "", "value for the flag")
+	addDefaultFlagsScopeFlags(defaultFlagsSetCmd)
 	reqs := []string{"flag", "value"}
 	for _, req := range reqs {
 		if err := defaultFlagsSetCmd.MarkFlagRequired(req); err != nil {
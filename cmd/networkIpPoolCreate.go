@@ -18,10 +18,9 @@ package cmd
 import (
 	"fmt"
 
-	"github.com/spf13/cast"
 	"github.com/spf13/cobra"
 
-	"github.com/liquidweb/liquidweb-cli/types/api"
+	lwClient "github.com/liquidweb/liquidweb-cli/client"
 )
 
 var networkIpPoolCreateCmdAddIpsFlag []string
@@ -41,22 +40,22 @@ your account.`,
 			lwCliInst.Die(fmt.Errorf("flags --new-ips --add-ips cannot both be empty. --zone cannot be empty"))
 		}
 
-		apiArgs := map[string]interface{}{
-			"zone_id": zoneFlag,
+		createReq := &lwClient.NetworkPoolCreateRequest{
+			ZoneId: zoneFlag,
 		}
 
 		if newIpsFlag != -1 {
-			apiArgs["new_ips"] = newIpsFlag
+			createReq.NewIps = &newIpsFlag
 		} else {
-			apiArgs["add_ips"] = networkIpPoolCreateCmdAddIpsFlag
+			createReq.AddIps = networkIpPoolCreateCmdAddIpsFlag
 		}
 
-		var details apiTypes.NetworkIpPoolDetails
-		if err := lwCliInst.CallLwApiInto("bleed/network/pool/create", apiArgs, &details); err != nil {
+		details, err := lwCliInst.Api.Network.Pool.Create(lwCliInst.Context(), createReq)
+		if err != nil {
 			lwCliInst.Die(err)
 		}
 
-		fmt.Print(details)
+		printResult(cmd, details)
 	},
 }
 
@@ -66,6 +65,6 @@ func init() {
 	networkIpPoolCreateCmd.Flags().StringSliceVar(&networkIpPoolCreateCmdAddIpsFlag, "add-ips", []string{},
 		"ips separated by ',' to add to created IP Pool")
 	networkIpPoolCreateCmd.Flags().Int64("new-ips", -1, "amount of IPs to assign to the created IP Pool")
-	networkIpPoolCreateCmd.Flags().Int64("zone", cast.ToInt64(defaultFlag("network_ip-pool_create_zone", -1)),
+	networkIpPoolCreateCmd.Flags().Int64("zone", -1,
 		"zone id to create the IP Pool in")
 }
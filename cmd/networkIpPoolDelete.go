@@ -21,7 +21,6 @@ import (
 
 	"github.com/spf13/cobra"
 
-	"github.com/liquidweb/liquidweb-cli/types/api"
 	"github.com/liquidweb/liquidweb-cli/validate"
 )
 
@@ -33,7 +32,7 @@ var networkIpPoolDeleteCmd = &cobra.Command{
 An IP Pool is a range of nonintersecting, reusable IP addresses reserved to
 your account.`,
 	Run: func(cmd *cobra.Command, args []string) {
-		uniqIdFlag, _ := cmd.Flags().GetString("uniq-id")
+		targets := requireTargets(cmd)
 		forceFlag, _ := cmd.Flags().GetBool("force")
 
 		// if force flag wasn't passed
@@ -44,33 +43,29 @@ your account.`,
 			}
 		}
 
-		validateFields := map[interface{}]interface{}{
-			uniqIdFlag: "UniqId",
-		}
-		if err := validate.Validate(validateFields); err != nil {
-			lwCliInst.Die(err)
-		}
+		runTargets(targets, func(uniqId string) error {
+			validateFields := map[interface{}]interface{}{
+				uniqId: "UniqId",
+			}
+			if err := validate.Validate(validateFields); err != nil {
+				return err
+			}
 
-		apiArgs := map[string]interface{}{
-			"uniq_id": uniqIdFlag,
-		}
+			details, err := lwCliInst.Api.Network.Pool.Delete(lwCliInst.Context(), uniqId)
+			if err != nil {
+				return err
+			}
 
-		var details apiTypes.NetworkIpPoolDelete
-		if err := lwCliInst.CallLwApiInto("bleed/network/pool/delete", apiArgs, &details); err != nil {
-			lwCliInst.Die(err)
-		}
+			fmt.Printf("Deleted IP Pool %s: %t\n", uniqId, details.Deleted)
 
-		fmt.Printf("Deleted IP Pool %t\n", details.Deleted)
+			return nil
+		})
 	},
 }
 
 func init() {
 	networkIpPoolCmd.AddCommand(networkIpPoolDeleteCmd)
 
-	networkIpPoolDeleteCmd.Flags().String("uniq-id", "", "uniq-id of IP Pool")
+	addTargetFlags(networkIpPoolDeleteCmd, "uniq-id of IP Pool")
 	networkIpPoolDeleteCmd.Flags().Bool("force", false, "bypass dialog confirmation")
-
-	if err := networkIpPoolDeleteCmd.MarkFlagRequired("uniq-id"); err != nil {
-		lwCliInst.Die(err)
-	}
 }
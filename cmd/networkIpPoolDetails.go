@@ -16,11 +16,9 @@ limitations under the License.
 package cmd
 
 import (
-	"fmt"
-
 	"github.com/spf13/cobra"
 
-	"github.com/liquidweb/liquidweb-cli/types/api"
+	lwClient "github.com/liquidweb/liquidweb-cli/client"
 	"github.com/liquidweb/liquidweb-cli/validate"
 )
 
@@ -32,37 +30,34 @@ var networkIpPoolDetailsCmd = &cobra.Command{
 An IP Pool is a range of nonintersecting, reusable IP addresses reserved to
 your account.`,
 	Run: func(cmd *cobra.Command, args []string) {
-		uniqIdFlag, _ := cmd.Flags().GetString("uniq-id")
 		freeOnlyFlag, _ := cmd.Flags().GetBool("free-only")
 
-		validateFields := map[interface{}]interface{}{
-			uniqIdFlag: "UniqId",
-		}
-		if err := validate.Validate(validateFields); err != nil {
-			lwCliInst.Die(err)
-		}
-
-		apiArgs := map[string]interface{}{
-			"uniq_id":   uniqIdFlag,
-			"free_only": freeOnlyFlag,
-		}
-
-		var details apiTypes.NetworkIpPoolDetails
-		if err := lwCliInst.CallLwApiInto("bleed/network/pool/details", apiArgs, &details); err != nil {
-			lwCliInst.Die(err)
-		}
-
-		fmt.Print(details)
+		runTargets(requireTargets(cmd), func(uniqId string) error {
+			validateFields := map[interface{}]interface{}{
+				uniqId: "UniqId",
+			}
+			if err := validate.Validate(validateFields); err != nil {
+				return err
+			}
+
+			details, err := lwCliInst.Api.Network.Pool.Details(lwCliInst.Context(), &lwClient.NetworkPoolDetailsRequest{
+				UniqId:   uniqId,
+				FreeOnly: freeOnlyFlag,
+			})
+			if err != nil {
+				return err
+			}
+
+			printResult(cmd, details)
+
+			return nil
+		})
 	},
 }
 
 func init() {
 	networkIpPoolCmd.AddCommand(networkIpPoolDetailsCmd)
 
-	networkIpPoolDetailsCmd.Flags().String("uniq-id", "", "uniq-id of IP Pool")
+	addTargetFlags(networkIpPoolDetailsCmd, "uniq-id of IP Pool")
 	networkIpPoolDetailsCmd.Flags().Bool("free-only", false, "return only unassigned IPs in the IP Pool")
-
-	if err := networkIpPoolDetailsCmd.MarkFlagRequired("uniq-id"); err != nil {
-		lwCliInst.Die(err)
-	}
 }
@@ -16,11 +16,10 @@ limitations under the License.
 package cmd
 
 import (
-	"fmt"
-
 	"github.com/spf13/cobra"
 
-	"github.com/liquidweb/liquidweb-cli/instance"
+	lwClient "github.com/liquidweb/liquidweb-cli/client"
+	"github.com/liquidweb/liquidweb-cli/output"
 	"github.com/liquidweb/liquidweb-cli/types/api"
 )
 
@@ -29,34 +28,38 @@ var networkIpPoolListCmd = &cobra.Command{
 	Short: "List IP Pools on your account",
 	Long:  `List IP Pools on your account`,
 	Run: func(cmd *cobra.Command, args []string) {
-		methodArgs := instance.AllPaginatedResultsArgs{
-			Method:         "bleed/network/pool/list",
-			ResultsPerPage: 100,
+		listQuery := newListQuery(cmd)
+		listOpts := lwClient.ListOptions{
+			PageSize: 100,
+			Limit:    listQuery.ListLimit(),
 		}
-		results, err := lwCliInst.AllPaginatedResults(&methodArgs)
+		printer := newListPrinter(cmd)
+		err := listQuery.Run(func(visit output.ItemFunc) error {
+			return lwCliInst.Api.Network.Pool.Each(lwCliInst.Context(), listOpts, func(listEntry *apiTypes.NetworkIpPoolListEntry) (bool, error) {
+				// now fetch details of Ip Pool id listEntry.Id
+				details, err := lwCliInst.Api.Network.Pool.Details(lwCliInst.Context(),
+					&lwClient.NetworkPoolDetailsRequest{Id: listEntry.Id})
+				if err != nil {
+					return false, err
+				}
+
+				return visit(details)
+			})
+		}, func(item interface{}) (bool, error) {
+			return true, printer.Add(item)
+		})
 		if err != nil {
 			lwCliInst.Die(err)
 		}
-
-		for _, item := range results.Items {
-
-			var listEntry apiTypes.NetworkIpPoolListEntry
-			if err := instance.CastFieldTypes(item, &listEntry); err != nil {
-				lwCliInst.Die(err)
-			}
-
-			// now fetch details of Ip Pool id listEntry.Id
-			var details apiTypes.NetworkIpPoolDetails
-			if err := lwCliInst.CallLwApiInto("bleed/network/pool/details", map[string]interface{}{
-				"id": listEntry.Id}, &details); err != nil {
-				lwCliInst.Die(err)
-			}
-
-			fmt.Print(details)
+		if err := printer.Flush(); err != nil {
+			lwCliInst.Die(err)
 		}
 	},
 }
 
 func init() {
 	networkIpPoolCmd.AddCommand(networkIpPoolListCmd)
+
+	networkIpPoolListCmd.Flags().Int64("limit", 0, "stop after listing this many IP Pools (0 for no limit)")
+	addListQueryFlags(networkIpPoolListCmd)
 }
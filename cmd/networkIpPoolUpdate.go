@@ -20,7 +20,7 @@ import (
 
 	"github.com/spf13/cobra"
 
-	"github.com/liquidweb/liquidweb-cli/types/api"
+	lwClient "github.com/liquidweb/liquidweb-cli/client"
 	"github.com/liquidweb/liquidweb-cli/validate"
 )
 
@@ -35,7 +35,7 @@ var networkIpPoolUpdateCmd = &cobra.Command{
 An IP Pool is a range of nonintersecting, reusable IP addresses reserved to
 your account.`,
 	Run: func(cmd *cobra.Command, args []string) {
-		uniqIdFlag, _ := cmd.Flags().GetString("uniq-id")
+		uniqIdFlag := singleTarget(cmd)
 		newIpsFlag, _ := cmd.Flags().GetInt64("new-ips")
 
 		validateFields := map[interface{}]interface{}{
@@ -48,24 +48,24 @@ your account.`,
 				"at least one of --remove-ips --add-ips --new-ips flags must be given"))
 		}
 
-		apiArgs := map[string]interface{}{
-			"uniq_id": uniqIdFlag,
+		updateReq := &lwClient.NetworkPoolUpdateRequest{
+			UniqId: uniqIdFlag,
 		}
 
 		if len(networkIpPoolUpdateCmdAddIpsFlag) > 0 {
-			apiArgs["add_ips"] = networkIpPoolUpdateCmdAddIpsFlag
+			updateReq.AddIps = networkIpPoolUpdateCmdAddIpsFlag
 			for _, ip := range networkIpPoolUpdateCmdAddIpsFlag {
 				validateFields[ip] = "IpOrCidr"
 			}
 		}
 		if len(networkIpPoolUpdateCmdRemoveIpsFlag) > 0 {
-			apiArgs["remove_ips"] = networkIpPoolUpdateCmdRemoveIpsFlag
+			updateReq.RemoveIps = networkIpPoolUpdateCmdRemoveIpsFlag
 			for _, ip := range networkIpPoolUpdateCmdRemoveIpsFlag {
 				validateFields[ip] = "IpOrCidr"
 			}
 		}
 		if newIpsFlag != -1 {
-			apiArgs["new_ips"] = newIpsFlag
+			updateReq.NewIps = newIpsFlag
 			validateFields[newIpsFlag] = "PositiveInt64"
 		}
 
@@ -73,8 +73,7 @@ your account.`,
 			lwCliInst.Die(err)
 		}
 
-		var details apiTypes.NetworkIpPoolDetails
-		err := lwCliInst.CallLwApiInto("bleed/network/pool/update", apiArgs, &details)
+		details, err := lwCliInst.Api.Network.Pool.Update(lwCliInst.Context(), updateReq)
 		if err != nil {
 			lwCliInst.Die(err)
 		}
@@ -91,9 +90,5 @@ func init() {
 	networkIpPoolUpdateCmd.Flags().StringSliceVar(&networkIpPoolUpdateCmdAddIpsFlag, "add-ips",
 		[]string{}, "ips separated by ',' to add to IP Pool")
 	networkIpPoolUpdateCmd.Flags().Int64("new-ips", -1, "amount of new IPs to assign to the IP Pool")
-	networkIpPoolUpdateCmd.Flags().String("uniq-id", "", "uniq-id of IP Pool")
-
-	if err := networkIpPoolUpdateCmd.MarkFlagRequired("uniq-id"); err != nil {
-		lwCliInst.Die(err)
-	}
+	addSingleTargetFlags(networkIpPoolUpdateCmd, "uniq-id of IP Pool")
 }
@@ -20,7 +20,6 @@ import (
 
 	"github.com/spf13/cobra"
 
-	"github.com/liquidweb/liquidweb-cli/types/api"
 	"github.com/liquidweb/liquidweb-cli/validate"
 )
 
@@ -33,39 +32,33 @@ A node is an ip address. You can only add ip addresses that are assigned to
 your account.
 `,
 	Run: func(cmd *cobra.Command, args []string) {
-		uniqIdFlag, _ := cmd.Flags().GetString("uniq-id")
 		nodeFlag, _ := cmd.Flags().GetString("node")
 
-		validateFields := map[interface{}]interface{}{
-			uniqIdFlag: "UniqId",
-			nodeFlag:   "IP",
-		}
-		if err := validate.Validate(validateFields); err != nil {
-			lwCliInst.Die(err)
-		}
+		runTargets(requireTargets(cmd), func(uniqId string) error {
+			validateFields := map[interface{}]interface{}{
+				uniqId:   "UniqId",
+				nodeFlag: "IP",
+			}
+			if err := validate.Validate(validateFields); err != nil {
+				return err
+			}
 
-		apiArgs := map[string]interface{}{
-			"uniq_id": uniqIdFlag,
-			"node":    nodeFlag,
-		}
+			details, err := lwCliInst.Api.Network.LoadBalancer.AddNode(lwCliInst.Context(), uniqId, nodeFlag)
+			if err != nil {
+				return err
+			}
 
-		var details apiTypes.NetworkLoadBalancerDetails
-		if err := lwCliInst.CallLwApiInto("bleed/network/loadbalancer/addnode", apiArgs,
-			&details); err != nil {
-			lwCliInst.Die(err)
-		}
+			fmt.Print(details)
 
-		fmt.Print(details)
+			return nil
+		})
 	},
 }
 
 func init() {
 	networkLoadBalancerCmd.AddCommand(networkLoadBalancerAddNodeCmd)
-	networkLoadBalancerAddNodeCmd.Flags().String("uniq-id", "", "uniq-id of Load Balancer")
+	addTargetFlags(networkLoadBalancerAddNodeCmd, "uniq-id of Load Balancer")
 	networkLoadBalancerAddNodeCmd.Flags().String("node", "", "node (ip) to add to the Load Balancer")
-	if err := networkLoadBalancerAddNodeCmd.MarkFlagRequired("uniq-id"); err != nil {
-		lwCliInst.Die(err)
-	}
 	if err := networkLoadBalancerAddNodeCmd.MarkFlagRequired("node"); err != nil {
 		lwCliInst.Die(err)
 	}
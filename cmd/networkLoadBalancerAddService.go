@@ -20,7 +20,6 @@ import (
 
 	"github.com/spf13/cobra"
 
-	"github.com/liquidweb/liquidweb-cli/types/api"
 	"github.com/liquidweb/liquidweb-cli/validate"
 )
 
@@ -31,44 +30,37 @@ var networkLoadBalancerAddServiceCmd = &cobra.Command{
 
 A service represents a service to load balance.`,
 	Run: func(cmd *cobra.Command, args []string) {
-		uniqIdFlag, _ := cmd.Flags().GetString("uniq-id")
 		srcPortFlag, _ := cmd.Flags().GetInt("src-port")
 		destPortFlag, _ := cmd.Flags().GetInt("dest-port")
 
-		validateFields := map[interface{}]interface{}{
-			uniqIdFlag:   "UniqId",
-			srcPortFlag:  "NetworkPort",
-			destPortFlag: "NetworkPort",
-		}
-		if err := validate.Validate(validateFields); err != nil {
-			lwCliInst.Die(err)
-		}
+		runTargets(requireTargets(cmd), func(uniqId string) error {
+			validateFields := map[interface{}]interface{}{
+				uniqId:       "UniqId",
+				srcPortFlag:  "NetworkPort",
+				destPortFlag: "NetworkPort",
+			}
+			if err := validate.Validate(validateFields); err != nil {
+				return err
+			}
 
-		apiArgs := map[string]interface{}{
-			"uniq_id":   uniqIdFlag,
-			"src_port":  srcPortFlag,
-			"dest_port": destPortFlag,
-		}
+			details, err := lwCliInst.Api.Network.LoadBalancer.AddService(lwCliInst.Context(), uniqId, srcPortFlag, destPortFlag)
+			if err != nil {
+				return err
+			}
 
-		var details apiTypes.NetworkLoadBalancerDetails
-		if err := lwCliInst.CallLwApiInto("bleed/network/loadbalancer/addservice", apiArgs,
-			&details); err != nil {
-			lwCliInst.Die(err)
-		}
+			fmt.Print(details)
 
-		fmt.Print(details)
+			return nil
+		})
 	},
 }
 
 func init() {
 	networkLoadBalancerCmd.AddCommand(networkLoadBalancerAddServiceCmd)
-	networkLoadBalancerAddServiceCmd.Flags().String("uniq-id", "", "uniq-id of Load Balancer")
+	addTargetFlags(networkLoadBalancerAddServiceCmd, "uniq-id of Load Balancer")
 	networkLoadBalancerAddServiceCmd.Flags().Int("src-port", -1, "source port")
 	networkLoadBalancerAddServiceCmd.Flags().Int("dest-port", -1, "destination port")
 
-	if err := networkLoadBalancerAddServiceCmd.MarkFlagRequired("uniq-id"); err != nil {
-		lwCliInst.Die(err)
-	}
 	if err := networkLoadBalancerAddServiceCmd.MarkFlagRequired("src-port"); err != nil {
 		lwCliInst.Die(err)
 	}
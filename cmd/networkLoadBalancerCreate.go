@@ -25,6 +25,7 @@ import (
 	"github.com/spf13/cobra"
 	"gopkg.in/yaml.v2"
 
+	lwClient "github.com/liquidweb/liquidweb-cli/client"
 	"github.com/liquidweb/liquidweb-cli/instance"
 	"github.com/liquidweb/liquidweb-cli/types/api"
 	"github.com/liquidweb/liquidweb-cli/types/cmd"
@@ -84,31 +85,35 @@ A Load Balancer allows you to distribute traffic to multiple endpoints.
 			nameFlag:     "NonEmptyString",
 		}
 
-		apiArgs := map[string]interface{}{
-			"name":     nameFlag,
-			"strategy": strategyFlag,
+		createReq := &lwClient.NetworkLoadBalancerCreateRequest{
+			Name:     nameFlag,
+			Strategy: strategyFlag,
 		}
 
 		if regionFlag != 0 {
 			validateFields[regionFlag] = "PositiveInt"
-			apiArgs["region"] = regionFlag
+			createReq.Region = regionFlag
 		}
 
 		// ssl termination
 		if enableSslTerminationFlag {
-			apiArgs["ssl_termination"] = true
+			sslTermination := true
+			createReq.SslTermination = &sslTermination
 		}
 		if disableSslTerminationFlag {
-			apiArgs["ssl_termination"] = false
+			sslTermination := false
+			createReq.SslTermination = &sslTermination
 		}
 
 		// ssl includes
 		if enableSslIncludesFlag {
-			apiArgs["ssl_includes"] = true
+			sslIncludes := true
+			createReq.SslIncludes = &sslIncludes
 			validateFields[sslIntermediateCertFlag] = "NonEmptyString"
 		}
 		if disableSslIncludesFlag {
-			apiArgs["ssl_includes"] = false
+			sslIncludes := false
+			createReq.SslIncludes = &sslIncludes
 		}
 
 		// read and set ssl cert
@@ -118,7 +123,7 @@ A Load Balancer allows you to distribute traffic to multiple endpoints.
 				lwCliInst.Die(err)
 			}
 			strContents := cast.ToString(contents)
-			apiArgs["ssl_cert"] = strContents
+			createReq.SslCert = strContents
 			validateFields[strContents] = "NonEmptyString"
 		}
 
@@ -129,7 +134,7 @@ A Load Balancer allows you to distribute traffic to multiple endpoints.
 				lwCliInst.Die(err)
 			}
 			strContents := cast.ToString(contents)
-			apiArgs["ssl_key"] = strContents
+			createReq.SslKey = strContents
 			validateFields[strContents] = "NonEmptyString"
 		}
 
@@ -140,13 +145,13 @@ A Load Balancer allows you to distribute traffic to multiple endpoints.
 				lwCliInst.Die(err)
 			}
 			strContents := cast.ToString(contents)
-			apiArgs["ssl_int"] = strContents
+			createReq.SslInt = strContents
 			validateFields[strContents] = "NonEmptyString"
 		}
 
 		// nodes
 		if len(networkLoadBalancerCreateNodesCmd) > 0 {
-			apiArgs["nodes"] = networkLoadBalancerCreateNodesCmd
+			createReq.Nodes = networkLoadBalancerCreateNodesCmd
 			for _, ip := range networkLoadBalancerCreateNodesCmd {
 				validateFields[ip] = "IP"
 			}
@@ -156,8 +161,8 @@ A Load Balancer allows you to distribute traffic to multiple endpoints.
 		if len(networkLoadBalancerCreateServicesCmd) == 0 {
 			lwCliInst.Die(fmt.Errorf("--services must have source/destination port pairs (see 'help network load-balancer create')"))
 		}
-		// slice of maps with keys src_port, dest_port, with a value of its network port number.
-		var servicesToBalance []map[string]interface{}
+		// source/destination port pairs, each with an optional health check.
+		var servicesToBalance []lwClient.NetworkLoadBalancerServiceSpec
 		// a service is permitted to have one health check
 
 		var healthChecks map[string]map[string]interface{}
@@ -204,20 +209,20 @@ A Load Balancer allows you to distribute traffic to multiple endpoints.
 			srcPort := cast.ToInt(splitPair[0])
 			destPort := cast.ToInt(splitPair[1])
 
-			serviceToBalance := map[string]interface{}{
-				"src_port":  srcPort,
-				"dest_port": destPort,
+			serviceToBalance := lwClient.NetworkLoadBalancerServiceSpec{
+				SrcPort:  srcPort,
+				DestPort: destPort,
 			}
 
 			// if a health check exists for this service set it
 			if _, exists := healthChecks[splitPair[0]]; exists {
-				serviceToBalance["health_check"] = healthChecks[splitPair[0]]
+				serviceToBalance.HealthCheck = healthChecks[splitPair[0]]
 			}
 
 			servicesToBalance = append(servicesToBalance, serviceToBalance)
 		}
 
-		apiArgs["services"] = servicesToBalance
+		createReq.Services = servicesToBalance
 
 		// validate built input
 		if err := validate.Validate(validateFields); err != nil {
@@ -225,13 +230,12 @@ A Load Balancer allows you to distribute traffic to multiple endpoints.
 		}
 
 		// call the method, display results
-		var create apiTypes.NetworkLoadBalancerDetails
-		if err := lwCliInst.CallLwApiInto("bleed/network/loadbalancer/create", apiArgs,
-			&create); err != nil {
+		create, err := lwCliInst.Api.Network.LoadBalancer.Create(lwCliInst.Context(), createReq)
+		if err != nil {
 			lwCliInst.Die(err)
 		}
 
-		fmt.Print(create)
+		printResult(cmd, create)
 	},
 }
 
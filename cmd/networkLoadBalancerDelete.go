@@ -21,7 +21,6 @@ import (
 
 	"github.com/spf13/cobra"
 
-	"github.com/liquidweb/liquidweb-cli/types/api"
 	"github.com/liquidweb/liquidweb-cli/validate"
 )
 
@@ -30,7 +29,7 @@ var networkLoadBalancerDeleteCmd = &cobra.Command{
 	Short: "Delete a Load Balancer",
 	Long:  `Delete a Load Balancer.`,
 	Run: func(cmd *cobra.Command, args []string) {
-		uniqIdFlag, _ := cmd.Flags().GetString("uniq-id")
+		targets := requireTargets(cmd)
 		forceFlag, _ := cmd.Flags().GetBool("force")
 
 		// if force flag wasn't passed
@@ -41,33 +40,28 @@ var networkLoadBalancerDeleteCmd = &cobra.Command{
 			}
 		}
 
-		validateFields := map[interface{}]interface{}{
-			uniqIdFlag: "UniqId",
-		}
-		if err := validate.Validate(validateFields); err != nil {
-			lwCliInst.Die(err)
-		}
+		runTargets(targets, func(uniqId string) error {
+			validateFields := map[interface{}]interface{}{
+				uniqId: "UniqId",
+			}
+			if err := validate.Validate(validateFields); err != nil {
+				return err
+			}
 
-		apiArgs := map[string]interface{}{
-			"uniq_id": uniqIdFlag,
-		}
+			details, err := lwCliInst.Api.Network.LoadBalancer.Delete(lwCliInst.Context(), uniqId)
+			if err != nil {
+				return err
+			}
 
-		var details apiTypes.NetworkLoadBalancerDelete
-		if err := lwCliInst.CallLwApiInto("bleed/network/loadbalancer/delete", apiArgs,
-			&details); err != nil {
-			lwCliInst.Die(err)
-		}
+			fmt.Printf("Deleted Load Balancer %s\n", details.Deleted)
 
-		fmt.Printf("Deleted Load Balancer %s\n", details.Deleted)
+			return nil
+		})
 	},
 }
 
 func init() {
 	networkLoadBalancerCmd.AddCommand(networkLoadBalancerDeleteCmd)
-	networkLoadBalancerDeleteCmd.Flags().String("uniq-id", "", "uniq-id of Load Balancer")
+	addTargetFlags(networkLoadBalancerDeleteCmd, "uniq-id of Load Balancer")
 	networkLoadBalancerDeleteCmd.Flags().Bool("force", false, "bypass dialog confirmation")
-
-	if err := networkLoadBalancerDeleteCmd.MarkFlagRequired("uniq-id"); err != nil {
-		lwCliInst.Die(err)
-	}
 }
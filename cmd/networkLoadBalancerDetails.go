@@ -16,11 +16,8 @@ limitations under the License.
 package cmd
 
 import (
-	"fmt"
-
 	"github.com/spf13/cobra"
 
-	"github.com/liquidweb/liquidweb-cli/types/api"
 	"github.com/liquidweb/liquidweb-cli/validate"
 )
 
@@ -29,33 +26,27 @@ var networkLoadBalancerDetailsCmd = &cobra.Command{
 	Short: "Get details of a Load Balancer",
 	Long:  `Get details of a Load Balancer.`,
 	Run: func(cmd *cobra.Command, args []string) {
-		uniqIdFlag, _ := cmd.Flags().GetString("uniq-id")
-
-		validateFields := map[interface{}]interface{}{
-			uniqIdFlag: "UniqId",
-		}
-		if err := validate.Validate(validateFields); err != nil {
-			lwCliInst.Die(err)
-		}
-
-		apiArgs := map[string]interface{}{
-			"uniq_id": uniqIdFlag,
-		}
-
-		var details apiTypes.NetworkLoadBalancerDetails
-		if err := lwCliInst.CallLwApiInto("bleed/network/loadbalancer/details", apiArgs,
-			&details); err != nil {
-			lwCliInst.Die(err)
-		}
-
-		fmt.Print(details)
+		runTargets(requireTargets(cmd), func(uniqId string) error {
+			validateFields := map[interface{}]interface{}{
+				uniqId: "UniqId",
+			}
+			if err := validate.Validate(validateFields); err != nil {
+				return err
+			}
+
+			details, err := lwCliInst.Api.Network.LoadBalancer.Details(lwCliInst.Context(), uniqId)
+			if err != nil {
+				return err
+			}
+
+			printResult(cmd, details)
+
+			return nil
+		})
 	},
 }
 
 func init() {
 	networkLoadBalancerCmd.AddCommand(networkLoadBalancerDetailsCmd)
-	networkLoadBalancerDetailsCmd.Flags().String("uniq-id", "", "uniq-id of Load Balancer")
-	if err := networkLoadBalancerDetailsCmd.MarkFlagRequired("uniq-id"); err != nil {
-		lwCliInst.Die(err)
-	}
+	addTargetFlags(networkLoadBalancerDetailsCmd, "uniq-id of Load Balancer")
 }
@@ -16,11 +16,8 @@ limitations under the License.
 package cmd
 
 import (
-	"fmt"
-
 	"github.com/spf13/cobra"
 
-	"github.com/liquidweb/liquidweb-cli/types/api"
 	"github.com/liquidweb/liquidweb-cli/validate"
 )
 
@@ -35,7 +32,8 @@ in that region.
 	Run: func(cmd *cobra.Command, args []string) {
 		regionIdFlag, _ := cmd.Flags().GetInt("region-id")
 
-		apiArgs := map[string]interface{}{}
+		// a region of 0 lists possible nodes in every region
+		var region int
 
 		if regionIdFlag != -1 {
 			validateFields := map[interface{}]interface{}{
@@ -44,16 +42,19 @@ in that region.
 			if err := validate.Validate(validateFields); err != nil {
 				lwCliInst.Die(err)
 			}
-			apiArgs["region"] = regionIdFlag
+			region = regionIdFlag
 		}
 
-		var details apiTypes.NetworkLoadBalancerPossibleNodes
-		if err := lwCliInst.CallLwApiInto("bleed/network/loadbalancer/possiblenodes",
-			apiArgs, &details); err != nil {
+		details, err := lwCliInst.Api.Network.LoadBalancer.PossibleNodes(lwCliInst.Context(), region)
+		if err != nil {
 			lwCliInst.Die(err)
 		}
 
-		fmt.Print(details)
+		if cmdOutputFormat(cmd).Itemized() {
+			printResult(cmd, details.Items)
+		} else {
+			printResult(cmd, details)
+		}
 	},
 }
 
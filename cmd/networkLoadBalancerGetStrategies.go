@@ -16,11 +16,7 @@ limitations under the License.
 package cmd
 
 import (
-	"fmt"
-
 	"github.com/spf13/cobra"
-
-	"github.com/liquidweb/liquidweb-cli/types/api"
 )
 
 var networkLoadBalancerGetStrategiesCmd = &cobra.Command{
@@ -30,12 +26,15 @@ var networkLoadBalancerGetStrategiesCmd = &cobra.Command{
 
 Gets a list of available strategies, with extra descriptive information.`,
 	Run: func(cmd *cobra.Command, args []string) {
-		var strategies apiTypes.NetworkLoadBalancerStrategies
-		if err := lwCliInst.CallLwApiInto("bleed/network/loadbalancer/strategies",
-			map[string]interface{}{}, &strategies); err != nil {
+		strategies, err := lwCliInst.Api.Network.LoadBalancer.Strategies(lwCliInst.Context())
+		if err != nil {
 			lwCliInst.Die(err)
 		}
-		fmt.Print(strategies)
+		if cmdOutputFormat(cmd).Itemized() {
+			printResult(cmd, strategies.Strategies)
+		} else {
+			printResult(cmd, strategies)
+		}
 	},
 }
 
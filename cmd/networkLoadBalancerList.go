@@ -16,11 +16,10 @@ limitations under the License.
 package cmd
 
 import (
-	"fmt"
-
 	"github.com/spf13/cobra"
 
-	"github.com/liquidweb/liquidweb-cli/instance"
+	lwClient "github.com/liquidweb/liquidweb-cli/client"
+	"github.com/liquidweb/liquidweb-cli/output"
 	"github.com/liquidweb/liquidweb-cli/types/api"
 )
 
@@ -29,25 +28,31 @@ var networkLoadBalancerListCmd = &cobra.Command{
 	Short: "list Load Balancers on account",
 	Long:  `list Load Balancers on account.`,
 	Run: func(cmd *cobra.Command, args []string) {
-		methodArgs := instance.AllPaginatedResultsArgs{
-			Method:         "bleed/network/loadbalancer/list",
-			ResultsPerPage: 100,
+		listQuery := newListQuery(cmd)
+		listOpts := lwClient.ListOptions{
+			PageSize: 100,
+			Limit:    listQuery.ListLimit(),
 		}
-		results, err := lwCliInst.AllPaginatedResults(&methodArgs)
+		printer := newListPrinter(cmd)
+		err := listQuery.Run(func(visit output.ItemFunc) error {
+			return lwCliInst.Api.Network.LoadBalancer.Each(lwCliInst.Context(), listOpts, func(details *apiTypes.NetworkLoadBalancerDetails) (bool, error) {
+				return visit(details)
+			})
+		}, func(item interface{}) (bool, error) {
+			return true, printer.Add(item)
+		})
 		if err != nil {
 			lwCliInst.Die(err)
 		}
-
-		for _, item := range results.Items {
-			var details apiTypes.NetworkLoadBalancerDetails
-			if err := instance.CastFieldTypes(item, &details); err != nil {
-				lwCliInst.Die(err)
-			}
-			fmt.Print(details)
+		if err := printer.Flush(); err != nil {
+			lwCliInst.Die(err)
 		}
 	},
 }
 
 func init() {
 	networkLoadBalancerCmd.AddCommand(networkLoadBalancerListCmd)
+
+	networkLoadBalancerListCmd.Flags().Int64("limit", 0, "stop after listing this many Load Balancers (0 for no limit)")
+	addListQueryFlags(networkLoadBalancerListCmd)
 }
@@ -20,7 +20,6 @@ import (
 
 	"github.com/spf13/cobra"
 
-	"github.com/liquidweb/liquidweb-cli/types/api"
 	"github.com/liquidweb/liquidweb-cli/validate"
 )
 
@@ -29,39 +28,33 @@ var networkLoadBalancerRemoveNodeCmd = &cobra.Command{
 	Short: "Remove a node from an existing Load Balancer",
 	Long:  `Remove a node (ip) from an existing Load Balancer.`,
 	Run: func(cmd *cobra.Command, args []string) {
-		uniqIdFlag, _ := cmd.Flags().GetString("uniq-id")
 		nodeFlag, _ := cmd.Flags().GetString("node")
 
-		validateFields := map[interface{}]interface{}{
-			uniqIdFlag: "UniqId",
-			nodeFlag:   "IP",
-		}
-		if err := validate.Validate(validateFields); err != nil {
-			lwCliInst.Die(err)
-		}
+		runTargets(requireTargets(cmd), func(uniqId string) error {
+			validateFields := map[interface{}]interface{}{
+				uniqId:   "UniqId",
+				nodeFlag: "IP",
+			}
+			if err := validate.Validate(validateFields); err != nil {
+				return err
+			}
 
-		apiArgs := map[string]interface{}{
-			"uniq_id": uniqIdFlag,
-			"node":    nodeFlag,
-		}
+			details, err := lwCliInst.Api.Network.LoadBalancer.RemoveNode(lwCliInst.Context(), uniqId, nodeFlag)
+			if err != nil {
+				return err
+			}
 
-		var details apiTypes.NetworkLoadBalancerDetails
-		if err := lwCliInst.CallLwApiInto("bleed/network/loadbalancer/removenode", apiArgs,
-			&details); err != nil {
-			lwCliInst.Die(err)
-		}
+			fmt.Print(details)
 
-		fmt.Print(details)
+			return nil
+		})
 	},
 }
 
 func init() {
 	networkLoadBalancerCmd.AddCommand(networkLoadBalancerRemoveNodeCmd)
-	networkLoadBalancerRemoveNodeCmd.Flags().String("uniq-id", "", "uniq-id of Load Balancer")
+	addTargetFlags(networkLoadBalancerRemoveNodeCmd, "uniq-id of Load Balancer")
 	networkLoadBalancerRemoveNodeCmd.Flags().String("node", "", "node (ip) to remove from the Load Balancer")
-	if err := networkLoadBalancerRemoveNodeCmd.MarkFlagRequired("uniq-id"); err != nil {
-		lwCliInst.Die(err)
-	}
 	if err := networkLoadBalancerRemoveNodeCmd.MarkFlagRequired("node"); err != nil {
 		lwCliInst.Die(err)
 	}
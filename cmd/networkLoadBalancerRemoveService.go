@@ -20,7 +20,6 @@ import (
 
 	"github.com/spf13/cobra"
 
-	"github.com/liquidweb/liquidweb-cli/types/api"
 	"github.com/liquidweb/liquidweb-cli/validate"
 )
 
@@ -31,40 +30,34 @@ var networkLoadBalancerRemoveServiceCmd = &cobra.Command{
 
 A service represents a service to load balance.`,
 	Run: func(cmd *cobra.Command, args []string) {
-		uniqIdFlag, _ := cmd.Flags().GetString("uniq-id")
 		srcPortFlag, _ := cmd.Flags().GetInt("src-port")
 
-		validateFields := map[interface{}]interface{}{
-			uniqIdFlag:  "UniqId",
-			srcPortFlag: "NetworkPort",
-		}
-		if err := validate.Validate(validateFields); err != nil {
-			lwCliInst.Die(err)
-		}
+		runTargets(requireTargets(cmd), func(uniqId string) error {
+			validateFields := map[interface{}]interface{}{
+				uniqId:      "UniqId",
+				srcPortFlag: "NetworkPort",
+			}
+			if err := validate.Validate(validateFields); err != nil {
+				return err
+			}
 
-		apiArgs := map[string]interface{}{
-			"uniq_id":  uniqIdFlag,
-			"src_port": srcPortFlag,
-		}
+			details, err := lwCliInst.Api.Network.LoadBalancer.RemoveService(lwCliInst.Context(), uniqId, srcPortFlag)
+			if err != nil {
+				return err
+			}
 
-		var details apiTypes.NetworkLoadBalancerDetails
-		if err := lwCliInst.CallLwApiInto("bleed/network/loadbalancer/removeservice", apiArgs,
-			&details); err != nil {
-			lwCliInst.Die(err)
-		}
+			fmt.Print(details)
 
-		fmt.Print(details)
+			return nil
+		})
 	},
 }
 
 func init() {
 	networkLoadBalancerCmd.AddCommand(networkLoadBalancerRemoveServiceCmd)
-	networkLoadBalancerRemoveServiceCmd.Flags().String("uniq-id", "", "uniq-id of Load Balancer")
+	addTargetFlags(networkLoadBalancerRemoveServiceCmd, "uniq-id of Load Balancer")
 	networkLoadBalancerRemoveServiceCmd.Flags().Int("src-port", -1,
 		"source port of service to remove from the Load Balancer")
-	if err := networkLoadBalancerRemoveServiceCmd.MarkFlagRequired("uniq-id"); err != nil {
-		lwCliInst.Die(err)
-	}
 	if err := networkLoadBalancerRemoveServiceCmd.MarkFlagRequired("src-port"); err != nil {
 		lwCliInst.Die(err)
 	}
@@ -25,6 +25,7 @@ import (
 	"github.com/spf13/cobra"
 	"gopkg.in/yaml.v2"
 
+	lwClient "github.com/liquidweb/liquidweb-cli/client"
 	"github.com/liquidweb/liquidweb-cli/instance"
 	"github.com/liquidweb/liquidweb-cli/types/api"
 	"github.com/liquidweb/liquidweb-cli/types/cmd"
@@ -100,7 +101,7 @@ network load-balancer update --uniq-id ABC123 --services 443:443,80:80
 Similarly to remove a health check when using --health-check-file, simply remove the health check from the file.
 `, networkLoadBalancerServicesHealthChecksHelp, networkLoadBalancerServicesHealthCheckFileHelp),
 	Run: func(cmd *cobra.Command, args []string) {
-		uniqIdFlag, _ := cmd.Flags().GetString("uniq-id")
+		uniqIdFlag := singleTarget(cmd)
 		nameFlag, _ := cmd.Flags().GetString("name")
 		strategyFlag, _ := cmd.Flags().GetString("strategy")
 		enableSslTerminationFlag, _ := cmd.Flags().GetBool("enable-ssl-termination")
@@ -136,36 +137,40 @@ Similarly to remove a health check when using --health-check-file, simply remove
 			uniqIdFlag: "UniqId",
 		}
 
-		apiArgs := map[string]interface{}{
-			"uniq_id": uniqIdFlag,
+		updateReq := &lwClient.NetworkLoadBalancerUpdateRequest{
+			UniqId: uniqIdFlag,
 		}
 
 		// ssl termination
 		if enableSslTerminationFlag {
-			apiArgs["ssl_termination"] = true
+			sslTermination := true
+			updateReq.SslTermination = &sslTermination
 		}
 		if disableSslTerminationFlag {
-			apiArgs["ssl_termination"] = false
+			sslTermination := false
+			updateReq.SslTermination = &sslTermination
 		}
 
 		// name
 		if nameFlag != "" {
-			apiArgs["name"] = nameFlag
+			updateReq.Name = nameFlag
 		}
 
 		// strategy
 		if strategyFlag != "" {
 			validateFields[strategyFlag] = "LoadBalancerStrategy"
-			apiArgs["strategy"] = strategyFlag
+			updateReq.Strategy = strategyFlag
 		}
 
 		// ssl includes
 		if enableSslIncludesFlag {
-			apiArgs["ssl_includes"] = true
+			sslIncludes := true
+			updateReq.SslIncludes = &sslIncludes
 			validateFields[sslIntermediateCertFlag] = "NonEmptyString"
 		}
 		if disableSslIncludesFlag {
-			apiArgs["ssl_includes"] = false
+			sslIncludes := false
+			updateReq.SslIncludes = &sslIncludes
 		}
 
 		// read and set ssl cert
@@ -175,7 +180,7 @@ Similarly to remove a health check when using --health-check-file, simply remove
 				lwCliInst.Die(err)
 			}
 			strContents := cast.ToString(contents)
-			apiArgs["ssl_cert"] = strContents
+			updateReq.SslCert = strContents
 			validateFields[strContents] = "NonEmptyString"
 		}
 
@@ -186,7 +191,7 @@ Similarly to remove a health check when using --health-check-file, simply remove
 				lwCliInst.Die(err)
 			}
 			strContents := cast.ToString(contents)
-			apiArgs["ssl_key"] = strContents
+			updateReq.SslKey = strContents
 			validateFields[strContents] = "NonEmptyString"
 		}
 
@@ -197,13 +202,13 @@ Similarly to remove a health check when using --health-check-file, simply remove
 				lwCliInst.Die(err)
 			}
 			strContents := cast.ToString(contents)
-			apiArgs["ssl_int"] = strContents
+			updateReq.SslInt = strContents
 			validateFields[strContents] = "NonEmptyString"
 		}
 
 		// nodes
 		if len(networkLoadBalancerUpdateNodesCmd) > 0 {
-			apiArgs["nodes"] = networkLoadBalancerUpdateNodesCmd
+			updateReq.Nodes = networkLoadBalancerUpdateNodesCmd
 			for _, ip := range networkLoadBalancerUpdateNodesCmd {
 				validateFields[ip] = "IP"
 			}
@@ -211,7 +216,7 @@ Similarly to remove a health check when using --health-check-file, simply remove
 
 		// services
 		if len(networkLoadBalancerUpdateServicesCmd) > 0 {
-			var servicesToBalance []map[string]interface{}
+			var servicesToBalance []lwClient.NetworkLoadBalancerServiceSpec
 			// a service is permitted to have one health check.
 
 			var healthChecks map[string]map[string]interface{}
@@ -258,22 +263,22 @@ Similarly to remove a health check when using --health-check-file, simply remove
 				srcPort := cast.ToInt(splitPair[0])
 				destPort := cast.ToInt(splitPair[1])
 
-				serviceToBalance := map[string]interface{}{
-					"src_port":  srcPort,
-					"dest_port": destPort,
+				serviceToBalance := lwClient.NetworkLoadBalancerServiceSpec{
+					SrcPort:  srcPort,
+					DestPort: destPort,
 				}
 
 				// if a health check exists for this service set it
 				if _, exists := healthChecks[splitPair[0]]; exists {
-					serviceToBalance["health_check"] = healthChecks[splitPair[0]]
+					serviceToBalance.HealthCheck = healthChecks[splitPair[0]]
 				}
 
 				servicesToBalance = append(servicesToBalance, serviceToBalance)
 			}
-			apiArgs["services"] = servicesToBalance
+			updateReq.Services = servicesToBalance
 		}
 
-		if len(apiArgs) == 1 {
+		if !updateReq.HasChanges() {
 			lwCliInst.Die(fmt.Errorf("Must pass something to update. See 'help network load-balancer update'"))
 		}
 
@@ -283,9 +288,8 @@ Similarly to remove a health check when using --health-check-file, simply remove
 		}
 
 		// call the method, display results
-		var update apiTypes.NetworkLoadBalancerDetails
-		if err := lwCliInst.CallLwApiInto("bleed/network/loadbalancer/update", apiArgs,
-			&update); err != nil {
+		update, err := lwCliInst.Api.Network.LoadBalancer.Update(lwCliInst.Context(), updateReq)
+		if err != nil {
 			lwCliInst.Die(err)
 		}
 
@@ -295,7 +299,7 @@ Similarly to remove a health check when using --health-check-file, simply remove
 
 func init() {
 	networkLoadBalancerCmd.AddCommand(networkLoadBalancerUpdateCmd)
-	networkLoadBalancerUpdateCmd.Flags().String("uniq-id", "", "uniq-id of Load Balancer")
+	addSingleTargetFlags(networkLoadBalancerUpdateCmd, "uniq-id of Load Balancer")
 	networkLoadBalancerUpdateCmd.Flags().String("strategy", "", "Load Balancer strategy (see 'network load-balancer get-strategies')")
 	networkLoadBalancerUpdateCmd.Flags().String("name", "", "name of Load Balancer")
 	networkLoadBalancerUpdateCmd.Flags().Bool("enable-ssl-termination", false, "enable ssl termination")
@@ -317,8 +321,4 @@ func init() {
 
 	networkLoadBalancerUpdateCmd.Flags().String("health-check-file", "",
 		"A file containing valid yaml describing the LoadBalancer health checks to add for the service(s). Should not be combined with --health-check.")
-
-	if err := networkLoadBalancerUpdateCmd.MarkFlagRequired("uniq-id"); err != nil {
-		lwCliInst.Die(err)
-	}
 }
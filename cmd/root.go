@@ -16,23 +16,47 @@ limitations under the License.
 package cmd
 
 import (
+	"context"
 	"fmt"
 	"os"
-	"regexp"
+	"os/signal"
 	"strings"
+	"syscall"
+	"time"
 
 	"github.com/c-bata/go-prompt"
+	"github.com/spf13/cast"
 	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"golang.org/x/crypto/ssh/terminal"
 
 	"github.com/liquidweb/liquidweb-cli/config"
 	"github.com/liquidweb/liquidweb-cli/flags/defaults"
 	"github.com/liquidweb/liquidweb-cli/instance"
+	lwCliInstApi "github.com/liquidweb/liquidweb-cli/instance/api"
+	"github.com/liquidweb/liquidweb-cli/output"
+	"github.com/liquidweb/liquidweb-cli/types/errors"
 	"github.com/liquidweb/liquidweb-cli/utils"
 )
 
 var cfgFile string
 var lwCliInst *instance.Client
 var useContext string
+var noCache bool
+var timeout time.Duration
+var outputFlag string
+var queryFlag string
+var colorFlag string
+var quietFlag bool
+
+// outputFormat and outputExpression are the parsed --output flag. See cmdOutputFormat.
+var outputFormat output.Format
+var outputExpression string
+
+// rootContext is cancelled on the first interrupt (Ctrl-C) or SIGTERM. initConfig
+// derives the context handed to the api client from it, adding any --timeout.
+var rootContext = context.Background()
+var rootCancels []context.CancelFunc
 
 var rootCmd = &cobra.Command{
 	Use:   "lw",
@@ -51,14 +75,57 @@ To setup your first auth context, you run 'auth init'. For further
 information on auth contexts, be sure to checkout 'help auth' for a
 list of capabilities.
 
+Flags not passed on the command line are taken from the environment variable
+named after the command and the flag, then from default flags (see 'help
+default-flags'), and otherwise keep their built-in default. The --zone flag of
+'cloud server create' is read from LW_CLOUD_SERVER_CREATE_ZONE; each flag's
+variable is shown in its usage.
+
 As always, consult the various subcommands for specific features and
 capabilities.`,
+	PersistentPreRun: func(cmd *cobra.Command, args []string) {
+		applyFlagDefaults(cmd)
+	},
 }
 
 func Execute() {
-	if err := rootCmd.Execute(); err != nil {
+	var cancel context.CancelFunc
+	rootContext, cancel = context.WithCancel(context.Background())
+	rootCancels = append(rootCancels, cancel)
+	go handleInterrupts(cancel)
+
+	for _, cmd := range rootCmd.Commands() {
+		bindCommandFlags(cmd, true)
+	}
+
+	err := rootCmd.Execute()
+	for _, cancel := range rootCancels {
+		cancel()
+	}
+	if err != nil {
+		// commands report their own failures through Die, so anything reaching here is
+		// bad command line usage.
 		fmt.Println(err)
-		os.Exit(1)
+		os.Exit(errorTypes.ExitValidation)
+	}
+}
+
+// handleInterrupts cancels the root context on an interrupt arriving during an api
+// call, so the call is aborted and reported rather than the process being killed
+// mid-request. Any other interrupt, such as at a prompt or a second one, exits
+// immediately.
+func handleInterrupts(cancel context.CancelFunc) {
+	signals := make(chan os.Signal, 2)
+	signal.Notify(signals, os.Interrupt, syscall.SIGTERM)
+
+	cancelled := false
+	for range signals {
+		if cancelled || !lwCliInstApi.InFlight() {
+			os.Exit(130)
+		}
+		utils.PrintYellow("\nInterrupt received; cancelling in-flight request. Interrupt again to exit immediately.\n")
+		cancel()
+		cancelled = true
 	}
 }
 
@@ -66,66 +133,264 @@ func init() {
 	cobra.OnInitialize(initConfig)
 
 	rootCmd.PersistentFlags().StringVar(&cfgFile, "config", "", "config file (default is $HOME/.liquidweb-cli.yaml)")
-	rootCmd.PersistentFlags().StringVar(&useContext, "use-context", "", "forces current context, without persisting the context change")
+	rootCmd.PersistentFlags().StringVar(&useContext, "use-context", "",
+		fmt.Sprintf("forces current context, without persisting the context change (env %s)", lwCliInstApi.EnvContext))
+	rootCmd.PersistentFlags().BoolVar(&noCache, "no-cache", false, "bypass the on-disk cache of catalog data (configs, templates, zones, regions, strategies, private parents)")
+	rootCmd.PersistentFlags().DurationVar(&timeout, "timeout", 0,
+		"abort the command if it hasn't finished within this duration (e.g. 30s, 5m). 0 for no deadline")
+	rootCmd.PersistentFlags().StringVarP(&outputFlag, "output", "o", "",
+		fmt.Sprintf("output format; one of %s. Default is human readable text", output.FormatNames()))
+	rootCmd.PersistentFlags().StringVar(&queryFlag, "query", "",
+		"JSONPath (or jq style) expression picking values out of the json output, e.g. '.items[].ip'")
+	rootCmd.PersistentFlags().BoolVarP(&quietFlag, "quiet", "q", false,
+		"print only the identifiers (uniq-ids, ids) of listed or created resources, one per line")
+	rootCmd.PersistentFlags().StringVar(&colorFlag, "color", string(utils.ColorAuto),
+		fmt.Sprintf("when to color output; one of auto, always, never. Defaults to the %s config setting, or auto",
+			utils.ColorConfigKey))
 }
 
+// setConfigArgs hands the --config and --use-context flags to config. Nothing reads
+// the config before cobra has parsed them; default flags are applied afterwards, by
+// applyFlagDefaults.
 func setConfigArgs() {
 	config.UseContextArg = useContext
 	config.ConfigFileArg = cfgFile
+}
+
+// bindCommandFlags lets the flags of cmd and its subcommands be set from the
+// environment, noting the variable in their usage, and permits defaults for them
+// unless permitDefaults is false. See applyFlagDefaults.
+func bindCommandFlags(cmd *cobra.Command, permitDefaults bool) {
+	// commands managing auth contexts and default flags shouldn't be fed by them
+	if cmd == authCmd || cmd == defaultFlagsCmd || cmd == completionCmd {
+		permitDefaults = false
+	}
 
-	if config.UseContextArg == "" {
-		osArgsForContext(regexp.MustCompile(`use-context\s+[A-z]+`))
-		if config.UseContextArg == "" {
-			osArgsForContext(regexp.MustCompile(`use-context=[A-z]+`))
+	cmd.LocalNonPersistentFlags().VisitAll(func(flag *pflag.Flag) {
+		if !boundFlag(flag) {
+			return
+		}
+		flag.Usage = fmt.Sprintf("%s (env %s)", flag.Usage, flagEnvName(cmd, flag))
+		if permitDefaults {
+			defaults.Register(defaultFlagName(cmd, flag), flag.Value.Type())
 		}
+	})
+
+	for _, child := range cmd.Commands() {
+		bindCommandFlags(child, permitDefaults)
 	}
 }
 
-// this gets called early on before cobra fully initializes, so have to
-// parse os.Args directly.
-func osArgsForContext(re *regexp.Regexp) {
-	var searchStr string
-	for _, str := range os.Args {
-		searchStr = searchStr + " " + str
-	}
+func boundFlag(flag *pflag.Flag) bool {
+	return flag.Name != "help" && !flag.Hidden && flag.Deprecated == ""
+}
 
-	delimiter := " "
-	if strings.Contains(searchStr, "=") {
-		delimiter = "="
-	}
+// defaultFlagName returns the name defaults for flag of cmd are set under, such as
+// cloud_server_create_zone.
+func defaultFlagName(cmd *cobra.Command, flag *pflag.Flag) string {
+	path := strings.Fields(cmd.CommandPath())[1:]
 
-	slice := strings.Split(re.FindString(searchStr), delimiter)
-	if len(slice) > 1 && slice[1] != "" {
-		config.UseContextArg = slice[1]
-		config.CurrentContext = slice[1]
-	}
+	return fmt.Sprintf("%s_%s", strings.Join(path, "_"), flag.Name)
 }
 
-func defaultFlag(flag string, defaultValueList ...interface{}) (value interface{}) {
-	// calling config.InitConfig() here so default context gets set
-	_, _ = config.InitConfig()
-	setConfigArgs()
-	value = defaults.GetOrNag(flag)
-	if len(defaultValueList) > 0 && value == nil {
-		value = defaultValueList[0]
-	}
-	return
+// flagEnvName returns the environment variable flag of cmd can be set from, such as
+// LW_CLOUD_SERVER_CREATE_ZONE.
+func flagEnvName(cmd *cobra.Command, flag *pflag.Flag) string {
+	name := strings.ReplaceAll(defaultFlagName(cmd, flag), "-", "_")
+
+	return "LW_" + strings.ToUpper(name)
+}
+
+// applyFlagDefaults sets the flags of cmd that weren't passed, as though they had
+// been, from their environment variable or else their default flag. Flags neither
+// sets keep their built-in default.
+func applyFlagDefaults(cmd *cobra.Command) {
+	cmd.LocalNonPersistentFlags().VisitAll(func(flag *pflag.Flag) {
+		if flag.Changed || !boundFlag(flag) {
+			return
+		}
+
+		envName := flagEnvName(cmd, flag)
+		if value := os.Getenv(envName); value != "" {
+			if err := cmd.Flags().Set(flag.Name, value); err != nil {
+				lwCliInst.Die(fmt.Errorf("%w: environment variable [%s]: %s",
+					errorTypes.LwCliInvalidFlagValue, envName, err))
+			}
+			return
+		}
+
+		name := defaultFlagName(cmd, flag)
+		value := defaults.GetOrNag(name)
+		if value == nil {
+			return
+		}
+
+		// lists are set like they are passed on the command line
+		str := cast.ToString(value)
+		if list, isList := value.([]interface{}); isList {
+			str = strings.Join(cast.ToStringSlice(list), ",")
+		}
+		if err := cmd.Flags().Set(flag.Name, str); err != nil {
+			lwCliInst.Die(fmt.Errorf("%w: default flag [%s]: %s", errorTypes.LwCliInvalidFlagValue, name, err))
+		}
+	})
 }
 
 func initConfig() {
+	// --color applies straight away, so problems reading the config are reported in
+	// the requested mode too.
+	colorChanged := rootCmd.PersistentFlags().Changed("color")
+	if colorChanged {
+		setColorMode(colorFlag)
+	}
+
+	setConfigArgs()
 	vp, err := config.InitConfig()
 	if err != nil {
 		lwCliInst.Die(err)
 	}
+	if !colorChanged {
+		setColorMode(vp.GetString(utils.ColorConfigKey))
+	}
 
 	var lwCliInstErr error
 	lwCliInst, lwCliInstErr = instance.New(vp)
 	if lwCliInstErr != nil {
 		lwCliInst.Die(lwCliInstErr)
 	}
+	lwCliInst.LwCliApiClient.NoCache = noCache
+
+	ctx := rootContext
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		rootCancels = append(rootCancels, cancel)
+	}
+	lwCliInst.LwCliApiClient.Context = ctx
+
+	if outputFormat, outputExpression, err = output.ParseFormat(outputFlag); err != nil {
+		lwCliInst.Die(err)
+	}
+	if queryFlag != "" {
+		if _, err := output.CompilePath(queryFlag); err != nil {
+			lwCliInst.Die(err)
+		}
+		if outputFormat != output.Text && outputFormat != output.Json && outputFormat != output.Yaml {
+			lwCliInst.Die(fmt.Errorf("%w: --query can only be combined with --output json or yaml",
+				errorTypes.LwCliInvalidFlagValue))
+		}
+	}
+	if quietFlag && (outputFormat != output.Text || queryFlag != "") {
+		lwCliInst.Die(fmt.Errorf("%w: --quiet can't be combined with --output or --query",
+			errorTypes.LwCliInvalidFlagValue))
+	}
+}
+
+func setColorMode(value string) {
+	mode, err := utils.ParseColorMode(value)
+	if err != nil {
+		lwCliInst.Die(err)
+	}
+	utils.SetColorMode(mode)
+}
+
+// cmdOutputFormat returns the format cmd should print its results in. Commands that
+// had a --json flag before --output existed still honor it. A --query without an
+// --output prints the values it picks, as --output jsonpath would. --quiet overrides
+// them all.
+func cmdOutputFormat(cmd *cobra.Command) output.Format {
+	if quietFlag {
+		return output.Quiet
+	}
+
+	format := outputFormat
+	if jsonFlag, _ := cmd.Flags().GetBool("json"); jsonFlag {
+		format = output.Json
+	}
+	if format == output.Text && queryFlag != "" {
+		format = output.JsonPath
+	}
+	if columnsFlag, _ := cmd.Flags().GetStringSlice("columns"); format == output.Text && len(columnsFlag) > 0 {
+		format = output.Table
+	}
+
+	return format
+}
+
+// newPrinter returns a printer for cmd's results on stdout.
+func newPrinter(cmd *cobra.Command) *output.Printer {
+	return configurePrinter(output.NewPrinter(os.Stdout, cmdOutputFormat(cmd)))
+}
+
+func configurePrinter(printer *output.Printer) *output.Printer {
+	switch {
+	case printer.Format == output.JsonPath && outputExpression == "":
+		printer.Expression = queryFlag
+	case printer.Format == output.Json || printer.Format == output.Yaml:
+		printer.Query = queryFlag
+	default:
+		printer.Expression = outputExpression
+	}
+
+	return printer
+}
+
+// printResult prints the result of cmd in its output format.
+func printResult(cmd *cobra.Command, result interface{}) {
+	printer := newPrinter(cmd)
+	if err := printer.Print(result); err != nil {
+		lwCliInst.Die(err)
+	}
+	if err := printer.Flush(); err != nil {
+		lwCliInst.Die(err)
+	}
+}
+
+// newListPrinter returns a printer for the items cmd lists on stdout.
+func newListPrinter(cmd *cobra.Command) *output.Printer {
+	printer := configurePrinter(output.NewListPrinter(os.Stdout, cmdOutputFormat(cmd)))
+	printer.Columns, _ = cmd.Flags().GetStringSlice("columns")
+
+	return printer
+}
+
+// addListQueryFlags adds the --filter, --sort-by and --columns flags shared by list
+// commands. See newListQuery.
+func addListQueryFlags(cmd *cobra.Command) {
+	cmd.Flags().String("filter", "",
+		"only list items matching these comma separated conditions (example: 'domain=~^web,zone.region.name=US Central')")
+	cmd.Flags().String("sort-by", "", "sort by this field, prefixed with - for descending order (example: -create_date)")
+	cmd.Flags().StringSlice("columns", []string{},
+		"table, wide or csv columns to print, separated by ',' (implies --output table)")
+}
+
+// newListQuery returns the filtering, sorting and --limit of a list command.
+func newListQuery(cmd *cobra.Command) *output.ListQuery {
+	filterFlag, _ := cmd.Flags().GetString("filter")
+	sortByFlag, _ := cmd.Flags().GetString("sort-by")
+
+	query := &output.ListQuery{}
+	query.Limit, _ = cmd.Flags().GetInt64("limit")
+
+	var err error
+	if query.Filter, err = output.ParseFilter(filterFlag); err != nil {
+		lwCliInst.Die(err)
+	}
+	if sortByFlag != "" {
+		if query.SortBy, err = output.ParseSortBy(sortByFlag); err != nil {
+			lwCliInst.Die(err)
+		}
+	}
+
+	return query
 }
 
 func dialogDesctructiveConfirmProceed() (proceed bool) {
+	// targets may have been piped in; there is then nobody to ask.
+	if !terminal.IsTerminal(int(os.Stdin.Fd())) {
+		lwCliInst.Die(fmt.Errorf("%w: cannot confirm a destructive operation without a terminal; pass --force",
+			errorTypes.LwCliInputError))
+	}
 
 	var haveConfirmationAnswer bool
 	utils.PrintTeal("Tip: Avoid future confirmations by passing --force\n\n")
@@ -0,0 +1,176 @@
+/*
+Copyright © LiquidWeb
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package cmd
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/liquidweb/liquidweb-cli/output"
+	"github.com/liquidweb/liquidweb-cli/types/errors"
+)
+
+// addTargetFlags adds the --uniq-id and --targets-file flags of commands acting on
+// existing resources. --uniq-id takes several ',' separated ids, or - to read them from
+// stdin, one per line.
+func addTargetFlags(cmd *cobra.Command, usage string) {
+	cmd.Flags().StringSlice("uniq-id", []string{},
+		fmt.Sprintf("%s. For multiple, must be ',' separated; - reads them from stdin, one per line", usage))
+	cmd.Flags().String("targets-file", "", "file to read uniq-ids from, one per line")
+}
+
+// addSingleTargetFlags is addTargetFlags for commands acting on a single resource. See
+// singleTarget.
+func addSingleTargetFlags(cmd *cobra.Command, usage string) {
+	cmd.Flags().StringSlice("uniq-id", []string{}, fmt.Sprintf("%s. - reads it from stdin", usage))
+	cmd.Flags().String("targets-file", "", "file to read the uniq-id from")
+}
+
+// cmdTargets returns the ids given by --uniq-id and --targets-file, in order and without
+// duplicates. Blank lines and lines starting with '#' are skipped when reading ids.
+func cmdTargets(cmd *cobra.Command) []string {
+	uniqIdFlag, _ := cmd.Flags().GetStringSlice("uniq-id")
+	targetsFileFlag, _ := cmd.Flags().GetString("targets-file")
+
+	var targets []string
+	seen := map[string]bool{}
+	add := func(target string) {
+		target = strings.TrimSpace(target)
+		if target != "" && !seen[target] {
+			seen[target] = true
+			targets = append(targets, target)
+		}
+	}
+
+	for _, uniqId := range uniqIdFlag {
+		if uniqId != "-" {
+			add(uniqId)
+			continue
+		}
+		ids, err := readTargets(os.Stdin)
+		if err != nil {
+			lwCliInst.Die(fmt.Errorf("failed reading uniq-ids from stdin: %s", err))
+		}
+		for _, id := range ids {
+			add(id)
+		}
+	}
+
+	if targetsFileFlag != "" {
+		file, err := os.Open(targetsFileFlag)
+		if err != nil {
+			lwCliInst.Die(fmt.Errorf("%w: --targets-file: %s", errorTypes.LwCliInvalidFlagValue, err))
+		}
+		defer file.Close()
+
+		ids, err := readTargets(file)
+		if err != nil {
+			lwCliInst.Die(fmt.Errorf("failed reading --targets-file [%s]: %s", targetsFileFlag, err))
+		}
+		for _, id := range ids {
+			add(id)
+		}
+	}
+
+	return targets
+}
+
+func readTargets(reader io.Reader) (targets []string, err error) {
+	scanner := bufio.NewScanner(reader)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		targets = append(targets, line)
+	}
+
+	return targets, scanner.Err()
+}
+
+// requireTargets is cmdTargets for commands needing at least one target.
+func requireTargets(cmd *cobra.Command) []string {
+	targets := cmdTargets(cmd)
+	if len(targets) == 0 {
+		lwCliInst.Die(fmt.Errorf("%w: give a target with --uniq-id or --targets-file", errorTypes.LwCliInputError))
+	}
+
+	return targets
+}
+
+// singleTarget returns the target of commands that can only act on one resource.
+func singleTarget(cmd *cobra.Command) string {
+	targets := requireTargets(cmd)
+	if len(targets) > 1 {
+		lwCliInst.Die(fmt.Errorf("%w: %s acts on a single uniq-id, but %d were given",
+			errorTypes.LwCliInvalidFlagValue, cmd.CommandPath(), len(targets)))
+	}
+
+	return targets[0]
+}
+
+// runTargets calls fn for each target. A failing target doesn't stop the others; it is
+// reported on stderr, and once all targets were tried the command dies with a
+// TargetsError. With a single target its error is fatal straight away, as before.
+func runTargets(targets []string, fn func(target string) error) {
+	if err := tryTargets(targets, fn); err != nil {
+		lwCliInst.Die(err)
+	}
+}
+
+// runPrintedTargets is runTargets for commands printing through printer; whatever the
+// targets that succeeded added to it is flushed before dying on the failed ones.
+func runPrintedTargets(printer *output.Printer, targets []string, fn func(target string) error) {
+	targetsErr := tryTargets(targets, fn)
+	if err := printer.Flush(); err != nil {
+		lwCliInst.Die(err)
+	}
+	if targetsErr != nil {
+		lwCliInst.Die(targetsErr)
+	}
+}
+
+// tryTargets calls fn for each target, returning a TargetsError if any of them failed.
+func tryTargets(targets []string, fn func(target string) error) error {
+	targetsErr := &errorTypes.TargetsError{Total: len(targets)}
+
+	for _, target := range targets {
+		err := fn(target)
+		if err == nil {
+			continue
+		}
+		if len(targets) == 1 || errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+			lwCliInst.Die(err)
+		}
+
+		failure := &errorTypes.TargetError{Target: target, Err: err}
+		targetsErr.Failures = append(targetsErr.Failures, failure)
+		fmt.Fprintf(os.Stderr, "FAILED %s\n", failure)
+	}
+
+	if len(targetsErr.Failures) > 0 {
+		return targetsErr
+	}
+
+	return nil
+}
@@ -0,0 +1,195 @@
+package config
+
+import (
+	"fmt"
+	"path/filepath"
+
+	homedir "github.com/mitchellh/go-homedir"
+	"github.com/spf13/viper"
+
+	"github.com/liquidweb/liquidweb-cli/instance"
+	"github.com/liquidweb/liquidweb-cli/secrets"
+	"github.com/liquidweb/liquidweb-cli/types/cmd"
+)
+
+// contextsPath is where the config file keeps auth contexts, by name.
+var contextsPath = []string{"liquidweb", "api", "contexts"}
+
+var currentContextPath = []string{"liquidweb", "api", "current_context"}
+
+func contextPath(name string, keys ...string) []string {
+	path := []string{}
+	path = append(path, contextsPath...)
+	path = append(path, name)
+
+	return append(path, keys...)
+}
+
+// File returns the config file vp reads, or would read once it exists.
+func File(vp *viper.Viper) (string, error) {
+	if file := vp.ConfigFileUsed(); file != "" {
+		return file, nil
+	}
+	if ConfigFileArg != "" {
+		return ConfigFileArg, nil
+	}
+
+	home, err := homedir.Dir()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(home, ".liquidweb-cli.yaml"), nil
+}
+
+// AuthContexts returns the auth contexts of the config file vp read, by name.
+func AuthContexts(vp *viper.Viper) (map[string]cmdTypes.AuthContext, error) {
+	contexts := map[string]cmdTypes.AuthContext{}
+	for name, contextInter := range vp.GetStringMap("liquidweb.api.contexts") {
+		var authContext cmdTypes.AuthContext
+		if err := instance.CastFieldTypes(contextInter, &authContext); err != nil {
+			return nil, err
+		}
+		contexts[name] = authContext
+	}
+
+	return contexts, nil
+}
+
+// SaveAuthContext writes authContext to the config file, replacing the context of the
+// same name, if any.
+func SaveAuthContext(vp *viper.Viper, authContext cmdTypes.AuthContext) error {
+	if authContext.ContextName == "" {
+		return fmt.Errorf("context cannot be empty")
+	}
+
+	return editConfig(vp, func(doc *Document) error {
+		return doc.Set(authContextSettings(authContext), contextPath(authContext.ContextName)...)
+	})
+}
+
+// RemoveAuthContext removes a context, other than the current one, from the config
+// file.
+func RemoveAuthContext(vp *viper.Viper, name string) error {
+	if name == "" {
+		return fmt.Errorf("context cannot be empty")
+	}
+	if name == vp.GetString("liquidweb.api.current_context") {
+		return fmt.Errorf("cannot remove context currently set as current context")
+	}
+	if err := instance.ValidateContext(name, vp); err != nil {
+		return fmt.Errorf("context %s doesnt exist, cannot remove", name)
+	}
+
+	return editConfig(vp, func(doc *Document) error {
+		doc.Remove(contextPath(name)...)
+		return nil
+	})
+}
+
+// SaveCurrentContext makes name the current context in the config file.
+func SaveCurrentContext(vp *viper.Viper, name string) error {
+	return editConfig(vp, func(doc *Document) error {
+		return doc.Set(name, currentContextPath...)
+	})
+}
+
+// ReplaceAuthContexts replaces every auth context of the config file with contexts,
+// making the one marked current the current context.
+func ReplaceAuthContexts(vp *viper.Viper, contexts []cmdTypes.AuthContext) error {
+	return editConfig(vp, func(doc *Document) error {
+		doc.Remove(contextsPath...)
+		doc.Remove(currentContextPath...)
+		for _, authContext := range contexts {
+			if err := doc.Set(authContextSettings(authContext), contextPath(authContext.ContextName)...); err != nil {
+				return err
+			}
+			if authContext.CurrentContext {
+				if err := doc.Set(authContext.ContextName, currentContextPath...); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	})
+}
+
+// editConfig applies edit to the config file and saves it at the current schema
+// version, then has vp read it again. The file is locked throughout, so concurrent lw
+// processes don't lose each other's edits.
+func editConfig(vp *viper.Viper, edit func(doc *Document) error) error {
+	file, err := File(vp)
+	if err != nil {
+		return err
+	}
+	unlock, err := Lock(file)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	if _, err := migrate(file, ConfigSchema); err != nil {
+		return err
+	}
+
+	doc, err := LoadDocument(file)
+	if err != nil {
+		return err
+	}
+	if err := edit(doc); err != nil {
+		return err
+	}
+	if err := ConfigSchema.Stamp(doc); err != nil {
+		return err
+	}
+	if err := doc.Save(); err != nil {
+		return err
+	}
+
+	vp.SetConfigFile(file)
+
+	return vp.ReadInConfig()
+}
+
+// authContextSettings returns the settings of authContext as the config file keeps
+// them. Its password or token is only included when kept in the config file.
+func authContextSettings(authContext cmdTypes.AuthContext) map[string]interface{} {
+	settings := map[string]interface{}{
+		"contextname":   authContext.ContextName,
+		"username":      authContext.Username,
+		"authtype":      cmdTypes.AuthTypePassword,
+		"url":           authContext.Url,
+		"insecure":      authContext.Insecure,
+		"timeout":       authContext.Timeout,
+		"proxy":         authContext.Proxy,
+		"cafile":        authContext.CaFile,
+		"clientcert":    authContext.ClientCert,
+		"clientkey":     authContext.ClientKey,
+		"tlsservername": authContext.TlsServerName,
+	}
+
+	if authContext.UsesToken() {
+		settings["authtype"] = authContext.AuthType
+		if authContext.TokenExpires != "" {
+			settings["tokenexpires"] = authContext.TokenExpires
+		}
+		if authContext.TokenRotated != "" {
+			settings["tokenrotated"] = authContext.TokenRotated
+		}
+	}
+	if authContext.PasswordStore == "" || authContext.PasswordStore == secrets.Plaintext {
+		if authContext.UsesToken() {
+			settings["token"] = authContext.Token
+		} else {
+			settings["password"] = authContext.Password
+		}
+	}
+	if authContext.PasswordStore != "" {
+		settings["passwordstore"] = authContext.PasswordStore
+	}
+	if authContext.PasswordCommand != "" {
+		settings["passwordcommand"] = authContext.PasswordCommand
+	}
+
+	return settings
+}
@@ -2,11 +2,14 @@ package config
 
 import (
 	"fmt"
+	"os"
 
 	homedir "github.com/mitchellh/go-homedir"
 	"github.com/spf13/viper"
 
 	"github.com/liquidweb/liquidweb-cli/instance"
+	"github.com/liquidweb/liquidweb-cli/instance/api"
+	"github.com/liquidweb/liquidweb-cli/types/cmd"
 	"github.com/liquidweb/liquidweb-cli/utils"
 )
 
@@ -19,6 +22,16 @@ var (
 func InitConfig() (vp *viper.Viper, err error) {
 	vp = viper.New()
 
+	// an ephemeral context from LW_* environment variables is used whatever the config
+	// file says, and is kept out of it so it is never written.
+	var envContext *cmdTypes.AuthContext
+	if envContext, err = api.EnvAuthContext(); err != nil {
+		return
+	}
+	if envContext != nil {
+		CurrentContext = envContext.ContextName
+	}
+
 	if ConfigFileArg != "" {
 		// Use config file from the flag.
 		vp.SetConfigFile(ConfigFileArg)
@@ -27,6 +40,10 @@ func InitConfig() (vp *viper.Viper, err error) {
 		var home string
 		home, err = homedir.Dir()
 		if err != nil {
+			// no home is fine when there's no need for a config file
+			if envContext != nil {
+				err = nil
+			}
 			return
 		}
 		vp.AddConfigPath(home)
@@ -34,6 +51,7 @@ func InitConfig() (vp *viper.Viper, err error) {
 	}
 
 	vp.AutomaticEnv()
+
 	if err = vp.ReadInConfig(); err != nil {
 		if _, notFound := err.(viper.ConfigFileNotFoundError); notFound {
 			err = nil
@@ -43,12 +61,31 @@ func InitConfig() (vp *viper.Viper, err error) {
 		return
 	}
 
-	if UseContextArg != "" {
-		if err = instance.ValidateContext(UseContextArg, vp); err != nil {
-			err = fmt.Errorf("error using auth context: %s\n", err)
+	var migrated bool
+	if migrated, err = Migrate(vp.ConfigFileUsed(), ConfigSchema); err != nil {
+		return
+	}
+	if migrated {
+		if err = vp.ReadInConfig(); err != nil {
+			return
+		}
+	}
+
+	if envContext != nil {
+		return
+	}
+
+	// without an ephemeral context to name, LW_CONTEXT picks a configured one
+	useContext, from := UseContextArg, "--use-context"
+	if useContext == "" {
+		useContext, from = os.Getenv(api.EnvContext), api.EnvContext
+	}
+	if useContext != "" {
+		if err = instance.ValidateContext(useContext, vp); err != nil {
+			err = fmt.Errorf("error using auth context from %s: %s\n", from, err)
 			return
 		}
-		vp.Set("liquidweb.api.current_context", UseContextArg)
+		vp.Set("liquidweb.api.current_context", useContext)
 	}
 
 	CurrentContext = vp.GetString("liquidweb.api.current_context")
@@ -0,0 +1,167 @@
+package config
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Document is a yaml file loaded for editing. Unlike a viper round trip, saving it
+// keeps the comments, key order and value types of everything not edited.
+type Document struct {
+	file string
+	// raw is the file as it was read.
+	raw  []byte
+	root *yaml.Node
+}
+
+// LoadDocument reads file. A missing or empty file is an empty document.
+func LoadDocument(file string) (doc *Document, err error) {
+	doc = &Document{file: file}
+
+	if doc.raw, err = ioutil.ReadFile(filepath.Clean(file)); err != nil {
+		if !os.IsNotExist(err) {
+			return
+		}
+		err = nil
+	}
+
+	var node yaml.Node
+	if err = yaml.Unmarshal(doc.raw, &node); err != nil {
+		err = fmt.Errorf("%w: [%s]: %s", ErrorUnreadable, file, err)
+		return
+	}
+	if len(node.Content) > 0 && node.Content[0].Kind == yaml.MappingNode {
+		doc.root = node.Content[0]
+	} else if len(node.Content) > 0 && node.Content[0].Tag != "!!null" {
+		err = fmt.Errorf("%w: [%s] isn't a yaml mapping", ErrorUnreadable, file)
+		return
+	} else {
+		doc.root = &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map"}
+	}
+
+	return
+}
+
+// Empty reports whether the document holds no settings.
+func (doc *Document) Empty() bool {
+	return len(doc.root.Content) == 0
+}
+
+// Lookup returns the node at the mapping keys of path, or nil when there is none.
+func (doc *Document) Lookup(path ...string) *yaml.Node {
+	node := doc.root
+	for _, key := range path {
+		if node = mappingValue(node, key); node == nil {
+			return nil
+		}
+	}
+
+	return node
+}
+
+// Decode decodes the node at path into out, leaving out as it is when there is none.
+func (doc *Document) Decode(out interface{}, path ...string) error {
+	node := doc.Lookup(path...)
+	if node == nil {
+		return nil
+	}
+
+	return node.Decode(out)
+}
+
+// Set sets the value at path, creating the mappings leading to it.
+func (doc *Document) Set(value interface{}, path ...string) error {
+	var node yaml.Node
+	if err := node.Encode(value); err != nil {
+		return err
+	}
+
+	parent := doc.root
+	for i, key := range path {
+		child := mappingValue(parent, key)
+		if i == len(path)-1 {
+			if child != nil {
+				*child = node
+			} else {
+				parent.Content = append(parent.Content, keyNode(key), &node)
+			}
+			break
+		}
+		if child == nil || child.Kind != yaml.MappingNode {
+			mapping := &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map"}
+			if child != nil {
+				*child = *mapping
+				mapping = child
+			} else {
+				parent.Content = append(parent.Content, keyNode(key), mapping)
+			}
+			child = mapping
+		}
+		parent = child
+	}
+
+	return nil
+}
+
+// Remove removes the value at path, reporting whether there was one.
+func (doc *Document) Remove(path ...string) bool {
+	if len(path) == 0 {
+		return false
+	}
+	parent := doc.Lookup(path[:len(path)-1]...)
+	if parent == nil || parent.Kind != yaml.MappingNode {
+		return false
+	}
+
+	for i := 0; i+1 < len(parent.Content); i += 2 {
+		if parent.Content[i].Value == path[len(path)-1] {
+			parent.Content = append(parent.Content[:i], parent.Content[i+2:]...)
+			return true
+		}
+	}
+
+	return false
+}
+
+// Save replaces the document's file with it, atomically, leaving the file only
+// readable by its owner.
+func (doc *Document) Save() (err error) {
+	var buf bytes.Buffer
+	encoder := yaml.NewEncoder(&buf)
+	encoder.SetIndent(4)
+	if err = encoder.Encode(doc.root); err != nil {
+		return
+	}
+	if err = encoder.Close(); err != nil {
+		return
+	}
+
+	if err = WriteFile(doc.file, buf.Bytes(), 0600); err != nil {
+		return
+	}
+	doc.raw = buf.Bytes()
+
+	return
+}
+
+func mappingValue(node *yaml.Node, key string) *yaml.Node {
+	if node == nil || node.Kind != yaml.MappingNode {
+		return nil
+	}
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			return node.Content[i+1]
+		}
+	}
+
+	return nil
+}
+
+func keyNode(key string) *yaml.Node {
+	return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: key}
+}
@@ -0,0 +1,10 @@
+package config
+
+import (
+	"errors"
+)
+
+var ErrorUnreadable = errors.New("config file cannot be read")
+var ErrorUnwritable = errors.New("config file cannot be written")
+var ErrorNewerSchema = errors.New("config file was written by a newer version of lw")
+var ErrorLocked = errors.New("config file is locked")
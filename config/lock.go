@@ -0,0 +1,105 @@
+/*
+Copyright © LiquidWeb
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package config
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/liquidweb/liquidweb-cli/secrets"
+)
+
+func init() {
+	// the password vault is edited under the same locks
+	secrets.LockFile = Lock
+}
+
+// LockTimeout is how long Lock waits for another lw process to release a lock.
+var LockTimeout = 10 * time.Second
+
+// errLockBusy is returned by tryLockFile when another process holds the lock.
+var errLockBusy = errors.New("lock is held elsewhere")
+
+// heldLocks are the locks this process holds, by lock file, so taking one again
+// nests instead of waiting on itself.
+var (
+	heldLocks   = map[string]*heldLock{}
+	heldLocksMu sync.Mutex
+)
+
+type heldLock struct {
+	file  *os.File
+	depth int
+}
+
+// Lock takes an advisory lock on file, to be released by calling unlock, so lw
+// processes editing it take turns. The lock is held on FILE.lock, since writes replace
+// file itself. Taking a lock this process already holds nests.
+func Lock(file string) (unlock func(), err error) {
+	if target, linkErr := filepath.EvalSymlinks(file); linkErr == nil {
+		file = target
+	}
+	lockFile := file + ".lock"
+
+	heldLocksMu.Lock()
+	defer heldLocksMu.Unlock()
+
+	held, exists := heldLocks[lockFile]
+	if !exists {
+		var f *os.File
+		if f, err = os.OpenFile(filepath.Clean(lockFile), os.O_RDWR|os.O_CREATE, 0600); err != nil {
+			err = fmt.Errorf("%w: locking [%s]: %s", ErrorUnwritable, file, err)
+			return
+		}
+		deadline := time.Now().Add(LockTimeout)
+		for {
+			if err = tryLockFile(f); err == nil {
+				break
+			}
+			if !errors.Is(err, errLockBusy) || time.Now().After(deadline) {
+				_ = f.Close()
+				if errors.Is(err, errLockBusy) {
+					err = fmt.Errorf("%w: [%s] is being edited by another lw process", ErrorLocked, file)
+				} else {
+					err = fmt.Errorf("%w: locking [%s]: %s", ErrorUnwritable, file, err)
+				}
+				return
+			}
+			time.Sleep(50 * time.Millisecond)
+		}
+		held = &heldLock{file: f}
+		heldLocks[lockFile] = held
+	}
+	held.depth++
+
+	unlock = func() {
+		heldLocksMu.Lock()
+		defer heldLocksMu.Unlock()
+
+		if held.depth--; held.depth > 0 {
+			return
+		}
+		_ = unlockFile(held.file)
+		_ = held.file.Close()
+		delete(heldLocks, lockFile)
+	}
+
+	return
+}
@@ -0,0 +1,157 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cast"
+
+	"github.com/liquidweb/liquidweb-cli/types/cmd"
+)
+
+// Schema is the versioned layout of a yaml file written by lw, and the migrations
+// upgrading files written in older versions of it.
+type Schema struct {
+	// Name describes the file in messages, e.g. "config".
+	Name string
+	// VersionPath is where the file records its schema version. Files without one are
+	// version 0, from before versioning.
+	VersionPath []string
+	// Migrations[i] upgrades version i to version i+1; the current version is
+	// len(Migrations).
+	Migrations []Migration
+}
+
+// Migration upgrades a document from one schema version to the next.
+type Migration struct {
+	Description string
+	Migrate     func(doc *Document) error
+}
+
+// Version is the current version of the schema.
+func (schema Schema) Version() int {
+	return len(schema.Migrations)
+}
+
+// Stamp records the current schema version in doc.
+func (schema Schema) Stamp(doc *Document) error {
+	return doc.Set(schema.Version(), schema.VersionPath...)
+}
+
+// Migrate upgrades file to the current version of schema, if it is older, keeping a
+// copy of the old file next to it as FILE.vN.bak. A file from a newer version of lw is
+// an error, rather than being misread. A missing or empty file is left for whatever
+// creates it to stamp. It reports whether the file was upgraded.
+func Migrate(file string, schema Schema) (migrated bool, err error) {
+	var doc *Document
+	if doc, err = LoadDocument(file); err != nil || doc.Empty() {
+		return
+	}
+	var version int
+	if version, err = schema.documentVersion(doc); err != nil || version == schema.Version() {
+		return
+	}
+
+	unlock, err := Lock(file)
+	if err != nil {
+		return
+	}
+	defer unlock()
+
+	return migrate(file, schema)
+}
+
+// migrate is Migrate, for callers already holding the lock on file.
+func migrate(file string, schema Schema) (migrated bool, err error) {
+	var doc *Document
+	if doc, err = LoadDocument(file); err != nil || doc.Empty() {
+		return
+	}
+	var version int
+	if version, err = schema.documentVersion(doc); err != nil || version == schema.Version() {
+		return
+	}
+
+	backup := fmt.Sprintf("%s.v%d.bak", file, version)
+	if err = WriteFile(backup, doc.raw, 0600); err != nil {
+		err = fmt.Errorf("backing up [%s] before upgrading it: %w", file, err)
+		return
+	}
+
+	for v := version; v < schema.Version(); v++ {
+		if err = schema.Migrations[v].Migrate(doc); err != nil {
+			err = fmt.Errorf("upgrading %s [%s] to schema version %d (%s): %w", schema.Name, file, v+1,
+				schema.Migrations[v].Description, err)
+			return
+		}
+	}
+	if err = schema.Stamp(doc); err != nil {
+		return
+	}
+	if err = doc.Save(); err != nil {
+		return
+	}
+
+	fmt.Fprintf(os.Stderr, "Upgraded %s [%s] from schema version %d to %d; the old file is kept as [%s]\n",
+		schema.Name, file, version, schema.Version(), backup)
+	migrated = true
+
+	return
+}
+
+// documentVersion is the schema version doc records, refusing versions newer than
+// this lw knows.
+func (schema Schema) documentVersion(doc *Document) (version int, err error) {
+	if node := doc.Lookup(schema.VersionPath...); node != nil {
+		if version, err = cast.ToIntE(node.Value); err != nil {
+			err = fmt.Errorf("%w: [%s] has an invalid %s: %s", ErrorUnreadable, doc.file,
+				strings.Join(schema.VersionPath, "."), node.Value)
+			return
+		}
+	}
+	if version > schema.Version() {
+		err = fmt.Errorf("%w: [%s] is %s schema version %d, this lw knows up to version %d; upgrade lw",
+			ErrorNewerSchema, doc.file, schema.Name, version, schema.Version())
+	}
+
+	return
+}
+
+// ConfigSchema is the layout of the config file holding auth contexts.
+var ConfigSchema = Schema{
+	Name:        "config file",
+	VersionPath: []string{"liquidweb", "config", "version"},
+	Migrations: []Migration{
+		{
+			Description: "record the name and auth type of every context",
+			Migrate:     migrateConfigContextFields,
+		},
+	},
+}
+
+// migrateConfigContextFields upgrades version 0 of ConfigSchema. Contexts are looked
+// up by their contextname, which hand written contexts could be missing, and contexts
+// from before API tokens have no auth type.
+func migrateConfigContextFields(doc *Document) error {
+	contexts := doc.Lookup(contextsPath...)
+	if contexts == nil {
+		return nil
+	}
+
+	for i := 0; i+1 < len(contexts.Content); i += 2 {
+		name := contexts.Content[i].Value
+		if doc.Lookup(contextPath(name, "contextname")...) == nil {
+			if err := doc.Set(name, contextPath(name, "contextname")...); err != nil {
+				return err
+			}
+		}
+		if doc.Lookup(contextPath(name, "authtype")...) == nil {
+			if err := doc.Set(cmdTypes.AuthTypePassword, contextPath(name, "authtype")...); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
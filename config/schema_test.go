@@ -0,0 +1,220 @@
+/*
+Copyright © LiquidWeb
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package config
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// configFixtures are config files as each schema version of ConfigSchema wrote them,
+// holding every context layout the version allowed.
+var configFixtures = map[int]string{
+	0: `liquidweb:
+  api:
+    contexts:
+      prod:
+        contextname: prod
+        username: alice
+        url: https://api.liquidweb.com
+      handwritten:
+        username: bob
+        url: https://api.liquidweb.com
+        authtype: token
+`,
+}
+
+func writeFixture(t *testing.T, content string) (file string) {
+	dir, err := ioutil.TempDir("", "lw-schema")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { os.RemoveAll(dir) })
+
+	file = filepath.Join(dir, "config.yaml")
+	if err := ioutil.WriteFile(file, []byte(content), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	return
+}
+
+func TestConfigSchemaMigrate(t *testing.T) {
+	for version := 0; version < ConfigSchema.Version(); version++ {
+		fixture, ok := configFixtures[version]
+		if !ok {
+			t.Errorf("no fixture for config schema version %d", version)
+			continue
+		}
+		file := writeFixture(t, fixture)
+
+		migrated, err := Migrate(file, ConfigSchema)
+		if err != nil {
+			t.Fatalf("version %d: %s", version, err)
+		}
+		if !migrated {
+			t.Errorf("version %d: not reported as migrated", version)
+		}
+
+		backup, err := ioutil.ReadFile(fmt.Sprintf("%s.v%d.bak", file, version))
+		if err != nil {
+			t.Fatalf("version %d: reading backup: %s", version, err)
+		}
+		if string(backup) != fixture {
+			t.Errorf("version %d: backup is %q, want the old file %q", version, backup, fixture)
+		}
+
+		doc, err := LoadDocument(file)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got, err := ConfigSchema.documentVersion(doc); err != nil || got != ConfigSchema.Version() {
+			t.Errorf("version %d: upgraded file is version %d (%v), want %d", version, got, err,
+				ConfigSchema.Version())
+		}
+		for _, check := range []struct {
+			path []string
+			want string
+		}{
+			{contextPath("prod", "contextname"), "prod"},
+			{contextPath("prod", "authtype"), "password"},
+			{contextPath("prod", "username"), "alice"},
+			{contextPath("handwritten", "contextname"), "handwritten"},
+			{contextPath("handwritten", "authtype"), "token"},
+		} {
+			node := doc.Lookup(check.path...)
+			if node == nil || node.Value != check.want {
+				t.Errorf("version %d: %s is %v, want %q", version, strings.Join(check.path, "."), node,
+					check.want)
+			}
+		}
+	}
+}
+
+// TestSchemaMigrateSteps checks a file of each older version runs exactly the
+// migrations after its version, in order.
+func TestSchemaMigrateSteps(t *testing.T) {
+	var ran []int
+	schema := Schema{Name: "test file", VersionPath: []string{"version"}}
+	for i := 0; i < 3; i++ {
+		step := i
+		schema.Migrations = append(schema.Migrations, Migration{
+			Description: fmt.Sprintf("step %d", step),
+			Migrate: func(doc *Document) error {
+				ran = append(ran, step)
+				return doc.Set(step+1, "steps", fmt.Sprintf("step%d", step))
+			},
+		})
+	}
+
+	for version := 0; version < schema.Version(); version++ {
+		ran = nil
+		fixture := "name: test\n"
+		if version > 0 {
+			fixture = fmt.Sprintf("version: %d\nname: test\n", version)
+		}
+		file := writeFixture(t, fixture)
+
+		if _, err := Migrate(file, schema); err != nil {
+			t.Fatalf("version %d: %s", version, err)
+		}
+
+		if len(ran) != schema.Version()-version {
+			t.Fatalf("version %d: ran migrations %v", version, ran)
+		}
+		for i, step := range ran {
+			if step != version+i {
+				t.Errorf("version %d: ran migrations %v, want them from %d in order", version, ran, version)
+				break
+			}
+		}
+		doc, err := LoadDocument(file)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if node := doc.Lookup("version"); node == nil || node.Value != fmt.Sprint(schema.Version()) {
+			t.Errorf("version %d: not stamped with version %d: %v", version, schema.Version(), node)
+		}
+		if node := doc.Lookup("name"); node == nil || node.Value != "test" {
+			t.Errorf("version %d: lost the rest of the file: %v", version, node)
+		}
+	}
+}
+
+func TestSchemaMigrateCurrent(t *testing.T) {
+	schema := Schema{
+		Name:        "test file",
+		VersionPath: []string{"version"},
+		Migrations: []Migration{{
+			Description: "fail",
+			Migrate:     func(doc *Document) error { return errors.New("migrated a current file") },
+		}},
+	}
+	for _, fixture := range []string{"version: 1\nname: test\n", ""} {
+		file := writeFixture(t, fixture)
+
+		migrated, err := Migrate(file, schema)
+		if err != nil || migrated {
+			t.Errorf("%q: migrated %v, err %v; want it left alone", fixture, migrated, err)
+		}
+		assertUntouched(t, file, fixture)
+	}
+}
+
+func TestSchemaMigrateNewer(t *testing.T) {
+	fixture := fmt.Sprintf("liquidweb:\n  config:\n    version: %d\n", ConfigSchema.Version()+1)
+	file := writeFixture(t, fixture)
+
+	migrated, err := Migrate(file, ConfigSchema)
+	if !errors.Is(err, ErrorNewerSchema) {
+		t.Errorf("err %v, want %v", err, ErrorNewerSchema)
+	}
+	if migrated {
+		t.Error("a newer file was reported migrated")
+	}
+	assertUntouched(t, file, fixture)
+
+	file = writeFixture(t, "liquidweb:\n  config:\n    version: nope\n")
+	if _, err := Migrate(file, ConfigSchema); !errors.Is(err, ErrorUnreadable) {
+		t.Errorf("invalid version: err %v, want %v", err, ErrorUnreadable)
+	}
+}
+
+// assertUntouched checks file still holds content, and no backup was made of it.
+func assertUntouched(t *testing.T, file, content string) {
+	t.Helper()
+
+	got, err := ioutil.ReadFile(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, []byte(content)) {
+		t.Errorf("file changed to %q, want %q", got, content)
+	}
+	backups, err := filepath.Glob(file + ".v*.bak")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(backups) != 0 {
+		t.Errorf("backups %v made", backups)
+	}
+}
@@ -0,0 +1,84 @@
+/*
+Copyright © LiquidWeb
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package config
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/viper"
+	"gopkg.in/yaml.v3"
+)
+
+// WriteFile replaces file with data atomically: data is written to a temporary file
+// beside it and renamed over it, so a crash mid-write leaves the old file whole. The
+// file is given mode perm, whatever mode it had before. A symlink is followed, and
+// its target replaced.
+func WriteFile(file string, data []byte, perm os.FileMode) (err error) {
+	if target, linkErr := filepath.EvalSymlinks(file); linkErr == nil {
+		file = target
+	}
+
+	tmp, err := ioutil.TempFile(filepath.Dir(file), fmt.Sprintf(".%s.tmp-", filepath.Base(file)))
+	if err != nil {
+		return fmt.Errorf("%w: [%s]: %s", ErrorUnwritable, file, err)
+	}
+	defer func() {
+		if err != nil {
+			_ = os.Remove(tmp.Name())
+			err = fmt.Errorf("%w: [%s]: %s", ErrorUnwritable, file, err)
+		}
+	}()
+
+	if err = tmp.Chmod(perm); err != nil {
+		_ = tmp.Close()
+		return
+	}
+	if _, err = tmp.Write(data); err != nil {
+		_ = tmp.Close()
+		return
+	}
+	if err = tmp.Sync(); err != nil {
+		_ = tmp.Close()
+		return
+	}
+	if err = tmp.Close(); err != nil {
+		return
+	}
+
+	err = os.Rename(tmp.Name(), file)
+
+	return
+}
+
+// WriteViper writes the settings of vp to the file it read, as WriteFile does. It
+// replaces vp.WriteConfig, which truncates the file in place.
+func WriteViper(vp *viper.Viper, perm os.FileMode) error {
+	var buf bytes.Buffer
+	encoder := yaml.NewEncoder(&buf)
+	encoder.SetIndent(4)
+	if err := encoder.Encode(vp.AllSettings()); err != nil {
+		return err
+	}
+	if err := encoder.Close(); err != nil {
+		return err
+	}
+
+	return WriteFile(vp.ConfigFileUsed(), buf.Bytes(), perm)
+}
@@ -3,3 +3,18 @@ package defaults
 const NagsKey = "nags"
 const DefFlagsKey = "defaults"
 const DefaultFlagsFileKey = "liquidweb.flags.defaults.file"
+
+// VersionKey records the schema version of the default flags file; see flagsSchema.
+const VersionKey = "version"
+
+// ProjectFileName is the project-local default flags file, looked for in the working
+// directory and then each of its parents.
+const ProjectFileName = ".lw-defaults.yaml"
+
+// projectFileMode is the mode of new project files, which are meant to be shared.
+const projectFileMode = 0644
+
+// Keys of the project file holding the flags for every auth context, and the flags
+// for particular auth contexts (as contexts.NAME).
+const ProjectDefaultsKey = "defaults"
+const ProjectContextsKey = "contexts"
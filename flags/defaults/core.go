@@ -5,22 +5,20 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
-	"sort"
-	"strings"
 
 	homedir "github.com/mitchellh/go-homedir"
-	"github.com/spf13/cast"
 	"github.com/spf13/viper"
 
 	"github.com/liquidweb/liquidweb-cli/config"
 	"github.com/liquidweb/liquidweb-cli/utils"
-	"github.com/liquidweb/liquidweb-cli/validate"
 )
 
 var (
 	nagged map[string]bool
 	nags   bool
 	tipped bool
+	// announced holds the project files GetOrNag has said it applied flags from.
+	announced = map[string]bool{}
 )
 
 func init() {
@@ -46,26 +44,25 @@ func NagsOn() (err error) {
 	return
 }
 
-func GetPermitted() (permitted []string) {
-	permitted = make([]string, 0, len(permittedFlags))
-	for flag, opts := range permittedFlags {
-		if enabled, ok := opts["enabled"].(bool); !enabled || !ok {
-			continue
-		}
-		permitted = append(permitted, flag)
+// GetOrNag returns the value of a default flag like Get, printing problems finding it
+// instead of returning them. Flags defaults are forbidden for are skipped silently.
+func GetOrNag(flag string) (value interface{}) {
+	permitted, err := permittedFlagOrError(flag)
+	if err != nil {
+		return
 	}
-	sort.Strings(permitted)
 
-	return
-}
-
-func GetOrNag(flag string) (value interface{}) {
-	var err error
-	value, err = Get(flag)
+	var from layer
+	value, from, err = get(flag)
+	if err == nil && from.project != "" && !announced[from.project] {
+		// project files are picked up from the working directory, so say when one is used
+		fmt.Fprintf(os.Stderr, "Using default flags from project file [%s]\n", from.project)
+		announced[from.project] = true
+	}
 	if err != nil {
 		if !nagged[flag] {
 			if errors.Is(err, ErrorNotFound) {
-				if nags {
+				if nags && permitted.nags() {
 					fmt.Printf("No default value for flag [%s] set. See 'help default-flags set' for details.\n", flag)
 					if !tipped {
 						utils.PrintTeal("TIP: You can silence undefined default flag notices with 'default-flags nags-off'\n")
@@ -81,153 +78,110 @@ func GetOrNag(flag string) (value interface{}) {
 	return
 }
 
+// Get returns the value of a default flag for the current auth context, from the most
+// specific layer setting it; see Resolve. Project files setting a flag they may not
+// are passed over.
 func Get(flag string) (value interface{}, err error) {
-	if _, err = permittedFlagOrError(flag); err != nil {
-		return
-	}
-
-	var flags map[string]interface{}
-	flags, err = getFlagsMap()
-	if err != nil {
-		return
-	}
+	value, _, err = get(flag)
 
-	if v, exists := flags[flag]; exists {
-		value = v
-		return
-	}
-
-	err = fmt.Errorf("%s %w", flag, ErrorNotFound)
 	return
 }
 
-func GetAll() (all AllFlags, err error) {
-	all, err = getFlagsMap()
-
-	return
-}
-
-func Set(flag string, value interface{}) (err error) {
-	var validator string
-	validator, err = permittedFlagOrError(flag)
-	if err != nil {
+// get is Get, also returning the layer the value came from.
+func get(flag string) (value interface{}, from layer, err error) {
+	var permitted permittedFlag
+	if permitted, err = permittedFlagOrError(flag); err != nil {
 		return
 	}
 
-	if err = validateFlagValue(validator, value); err != nil {
+	var layers []layer
+	if layers, err = getLayers(""); err != nil {
 		return
 	}
 
-	var (
-		vp    *viper.Viper
-		flags map[string]interface{}
-	)
-	vp, flags, err = getFlagsViperAndMap()
-	if err != nil {
-		return
+	for _, l := range layers {
+		if l.project != "" && !permitted.project {
+			continue
+		}
+		if v, exists := l.flags[flag]; exists {
+			// the project file is edited by hand, so its values weren't checked by Set
+			if err = permitted.validate(v); err != nil {
+				err = fmt.Errorf("%s in %s: %w", flag, l.source, err)
+				return
+			}
+			value = v
+			from = l
+			return
+		}
 	}
 
-	flags[flag] = value
-	vp.Set(contextFlagKey(), flags)
-
-	err = writeViperConfig(vp)
-
+	err = fmt.Errorf("%s %w", flag, ErrorNotFound)
 	return
 }
 
-func Delete(flag string) (err error) {
-	if _, err = permittedFlagOrError(flag); err != nil {
-		return
-	}
-
+// GetAll returns the default flags set in scope alone.
+func GetAll(scope Scope) (all AllFlags, err error) {
 	var (
-		vp    *viper.Viper
-		flags map[string]interface{}
+		vp  *viper.Viper
+		key string
 	)
-	vp, flags, err = getFlagsViperAndMap()
-	if err != nil {
+	if vp, key, err = scope.section(false); err != nil {
+		if errors.Is(err, ErrorNoProjectFile) {
+			err = nil
+		}
 		return
 	}
 
-	delete(flags, flag)
-	vp.Set(contextFlagKey(), flags)
-	err = writeViperConfig(vp)
+	all = vp.GetStringMap(key)
 
 	return
 }
 
-func permittedFlagOrError(flag string) (validator string, err error) {
-	if flag == "" {
-		err = ErrorInvalidFlagName
+func Set(scope Scope, flag string, value interface{}) (err error) {
+	var permitted permittedFlag
+	permitted, err = permittedFlagOrError(flag)
+	if err != nil {
 		return
 	}
-
-	opts, exists := permittedFlags[flag]
-	if !exists {
-		err = fmt.Errorf("%s %w", flag, ErrorForbiddenFlag)
+	if scope.Project && !permitted.project {
+		err = fmt.Errorf("%s %w", flag, ErrorForbiddenProjectFlag)
 		return
 	}
 
-	if v, ok := opts["enabled"].(bool); !ok || !v {
-		err = fmt.Errorf("%s %w", flag, ErrorForbiddenFlag)
-	}
-
-	validator = cast.ToString(opts["type"])
-
-	return
-}
-
-func validateFlagValue(validator string, value interface{}) (err error) {
-	var validateFields map[interface{}]interface{}
-
-	if strings.HasSuffix(validator, "Int64") {
-		validateFields = map[interface{}]interface{}{
-			cast.ToInt64(value): "PositiveInt64",
-		}
-	} else if strings.HasSuffix(validator, "Int") {
-		validateFields = map[interface{}]interface{}{
-			cast.ToInt(value): "PositiveInt64",
-		}
-	} else if strings.HasSuffix(validator, "String") {
-		validateFields = map[interface{}]interface{}{
-			cast.ToString(value): "NonEmptyString",
-		}
+	if err = permitted.validate(value); err != nil {
+		return
 	}
 
-	err = validate.Validate(validateFields)
+	err = scope.edit(true, func(flags map[string]interface{}) error {
+		flags[flag] = value
+		return nil
+	})
 
 	return
 }
 
-func getFlagsViperAndMap() (vp *viper.Viper, flags map[string]interface{}, err error) {
-	vp, err = getFlagsViper()
-	if err != nil {
+func Delete(scope Scope, flag string) (err error) {
+	if _, err = permittedFlagOrError(flag); err != nil {
 		return
 	}
 
-	flags, err = getFlagsMap(vp)
-
-	return
-}
-
-func getFlagsMap(vpL ...*viper.Viper) (flags map[string]interface{}, err error) {
-	var vp *viper.Viper
-	if len(vpL) == 0 {
-		if vp, err = getFlagsViper(); err != nil {
-			return
+	err = scope.edit(false, func(flags map[string]interface{}) error {
+		if _, exists := flags[flag]; !exists {
+			return fmt.Errorf("%s %w", flag, ErrorNotFound)
 		}
-	} else {
-		vp = vpL[0]
-	}
-
-	flags = vp.GetStringMap(contextFlagKey())
+		delete(flags, flag)
+		return nil
+	})
 
 	return
 }
 
-func getFlagsViper() (vp *viper.Viper, err error) {
+// getFlagsViper reads the user's default flags file. Only writers pass create, to
+// create it when missing; otherwise a missing file reads as empty, so looking default
+// flags up never writes to disk (an ephemeral LW_* auth context must not).
+func getFlagsViper(create bool) (vp *viper.Viper, err error) {
 	var file string
-	file, err = getFlagsFile()
+	file, err = getFlagsFile(create)
 	if err != nil {
 		return
 	}
@@ -235,6 +189,16 @@ func getFlagsViper() (vp *viper.Viper, err error) {
 	vp = viper.New()
 	vp.SetConfigFile(file)
 	vp.SetDefault(NagsKey, true)
+	// written out with the rest, so new files record their version too
+	vp.SetDefault(VersionKey, flagsSchema.Version())
+	if !create && !utils.FileExists(file) {
+		nags = vp.GetBool(NagsKey)
+		return
+	}
+
+	if _, err = config.Migrate(file, flagsSchema); err != nil {
+		return
+	}
 	if err = vp.ReadInConfig(); err != nil {
 		err = fmt.Errorf("%w: %s", ErrorUnreadable, err)
 		return
@@ -245,15 +209,27 @@ func getFlagsViper() (vp *viper.Viper, err error) {
 	return
 }
 
-func getFlagsFile() (file string, err error) {
+// getFlagsFile returns the user's default flags file, creating it when create is set
+// and it is missing.
+func getFlagsFile(create bool) (file string, err error) {
 	file = viper.GetString(DefaultFlagsFileKey)
 	if file == "" {
 		err = ErrorFileKeyMissing
+		return
 	}
 
+	if create {
+		err = createFile(file, 0600)
+	}
+
+	return
+}
+
+// createFile creates an empty file with mode perm, unless it already exists.
+func createFile(file string, perm os.FileMode) (err error) {
 	if _, err = os.Stat(file); os.IsNotExist(err) {
 		err = nil
-		f, ferr := os.Create(filepath.Clean(file))
+		f, ferr := os.OpenFile(filepath.Clean(file), os.O_WRONLY|os.O_CREATE|os.O_EXCL, perm)
 		if ferr != nil {
 			err = ferr
 			return
@@ -264,27 +240,38 @@ func getFlagsFile() (file string, err error) {
 	return
 }
 
-func contextFlagKey() (k string) {
-	k = fmt.Sprintf("%s.%s", DefFlagsKey, config.CurrentContext)
+func contextFlagKey(context string) (k string) {
+	k = fmt.Sprintf("%s.%s", DefFlagsKey, context)
 
 	return
 }
 
 func toggleNags(on bool) error {
-	vp, err := getFlagsViper()
+	file, err := getFlagsFile(true)
+	if err != nil {
+		return err
+	}
+	unlock, err := config.Lock(file)
 	if err != nil {
 		return err
 	}
+	defer unlock()
+	resolvedLayers = map[string][]layer{}
 
-	vp.Set(NagsKey, on)
+	vp, err := getFlagsViper(true)
+	if err != nil {
+		return err
+	}
 
-	err = writeViperConfig(vp)
+	vp.Set(NagsKey, on)
 
-	return err
+	return writeViperConfig(vp, 0600)
 }
 
-func writeViperConfig(vp *viper.Viper) (err error) {
-	if err = vp.WriteConfig(); err != nil {
+// writeViperConfig replaces the file vp read with its settings, atomically, with mode
+// perm.
+func writeViperConfig(vp *viper.Viper, perm os.FileMode) (err error) {
+	if err = config.WriteViper(vp, perm); err != nil {
 		err = fmt.Errorf("%w: %s", ErrorUnwritable, err)
 	}
 
@@ -5,8 +5,11 @@ import (
 )
 
 var ErrorForbiddenFlag = errors.New("is a forbidden default flag")
+var ErrorForbiddenProjectFlag = errors.New("can't be set in a project file")
 var ErrorInvalidFlagName = errors.New("the given flag name is invalid")
 var ErrorFileKeyMissing = errors.New("flag defaults file key is missing")
 var ErrorUnwritable = errors.New("flag defaults cannot be written")
 var ErrorUnreadable = errors.New("flag defaults cannot be read")
 var ErrorNotFound = errors.New("flag default not found")
+var ErrorNoProjectFile = errors.New("there is no project default flags file")
+var ErrorInvalidValue = errors.New("invalid default flag value")
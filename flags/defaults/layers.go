@@ -0,0 +1,245 @@
+package defaults
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/spf13/viper"
+
+	"github.com/liquidweb/liquidweb-cli/config"
+	"github.com/liquidweb/liquidweb-cli/utils"
+)
+
+// Scope picks where Set, Delete and GetAll find default flags. The zero Scope is the
+// current auth context in the user's default flags file.
+type Scope struct {
+	// Context is the auth context the flags apply to; the current one when empty,
+	// except in the project file, where empty means every auth context.
+	Context string
+	// Project is the project file (see ProjectFile) rather than the user's file.
+	Project bool
+}
+
+// layer is one source of default flags, as seen when resolving them.
+type layer struct {
+	source string
+	flags  map[string]interface{}
+	// project is set for the layers of a project file, naming it.
+	project string
+}
+
+// resolvedLayers holds the layers getLayers found for each auth context, so the files
+// are found and read once per command rather than for every flag looked up. Editing
+// default flags drops them.
+var resolvedLayers = map[string][]layer{}
+
+// ProjectFile returns the project-local default flags file nearest the working
+// directory, or an empty string when there is none.
+func ProjectFile() (file string, err error) {
+	var dir string
+	if dir, err = os.Getwd(); err != nil {
+		return
+	}
+
+	for {
+		candidate := filepath.Join(dir, ProjectFileName)
+		if utils.FileExists(candidate) {
+			file = candidate
+			return
+		}
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return
+		}
+		dir = parent
+	}
+}
+
+// Resolve returns the default flags applying to an auth context (the current one when
+// empty), each with the layer its value came from.
+func Resolve(context string) (resolved ResolvedFlags, err error) {
+	var layers []layer
+	if layers, err = getLayers(context); err != nil {
+		return
+	}
+
+	seen := map[string]bool{}
+	for _, l := range layers {
+		for flag, value := range l.flags {
+			if seen[flag] {
+				continue
+			}
+			r := ResolvedFlag{Flag: flag, Value: value, Source: l.source}
+			if permitted, err := permittedFlagOrError(flag); err != nil {
+				r.Ignored = "not a permitted default flag"
+			} else if l.project != "" && !permitted.project {
+				// a less specific layer may still set it
+				r.Ignored = "not permitted in a project file"
+				resolved = append(resolved, r)
+				continue
+			}
+			seen[flag] = true
+			resolved = append(resolved, r)
+		}
+	}
+	// layers are appended most specific first; keep that order within a flag
+	sort.SliceStable(resolved, func(i, j int) bool { return resolved[i].Flag < resolved[j].Flag })
+
+	return
+}
+
+// getLayers returns the sources of default flags for an auth context (the current one
+// when empty), most specific first: the project file's section for the context, the
+// project file's flags for every context, then the user's file.
+func getLayers(context string) (layers []layer, err error) {
+	if context == "" {
+		context = config.CurrentContext
+	}
+	if cached, ok := resolvedLayers[context]; ok {
+		layers = cached
+		return
+	}
+
+	var projectFile string
+	if projectFile, err = ProjectFile(); err != nil {
+		return
+	}
+	if projectFile != "" {
+		var vp *viper.Viper
+		if vp, err = getProjectViper(projectFile); err != nil {
+			return
+		}
+		if context != "" {
+			layers = append(layers, layer{
+				source:  fmt.Sprintf("project file [%s], context [%s]", projectFile, context),
+				flags:   vp.GetStringMap(projectContextKey(context)),
+				project: projectFile,
+			})
+		}
+		layers = append(layers, layer{
+			source:  fmt.Sprintf("project file [%s]", projectFile),
+			flags:   vp.GetStringMap(ProjectDefaultsKey),
+			project: projectFile,
+		})
+	}
+
+	var vp *viper.Viper
+	if vp, err = getFlagsViper(false); err != nil {
+		return
+	}
+	layers = append(layers, layer{
+		source: fmt.Sprintf("context [%s] in [%s]", context, vp.ConfigFileUsed()),
+		flags:  vp.GetStringMap(contextFlagKey(context)),
+	})
+	resolvedLayers[context] = layers
+
+	return
+}
+
+// section returns the viper of the file scope refers to, and the key of its flags.
+// When create is set, the file is created if missing: the user's file, or a project
+// file in the working directory. Otherwise a missing user file reads as empty, and a
+// missing project file is an ErrorNoProjectFile.
+func (scope Scope) section(create bool) (vp *viper.Viper, key string, err error) {
+	if !scope.Project {
+		context := scope.Context
+		if context == "" {
+			context = config.CurrentContext
+		}
+		if vp, err = getFlagsViper(create); err != nil {
+			return
+		}
+		key = contextFlagKey(context)
+		return
+	}
+
+	var file string
+	if file, err = ProjectFile(); err != nil {
+		return
+	}
+	if file == "" {
+		if !create {
+			err = fmt.Errorf("%w named %s in the working directory or its parents", ErrorNoProjectFile,
+				ProjectFileName)
+			return
+		}
+		var dir string
+		if dir, err = os.Getwd(); err != nil {
+			return
+		}
+		file = filepath.Join(dir, ProjectFileName)
+		if err = createFile(file, projectFileMode); err != nil {
+			return
+		}
+	}
+	if vp, err = getProjectViper(file); err != nil {
+		return
+	}
+
+	key = ProjectDefaultsKey
+	if scope.Context != "" {
+		key = projectContextKey(scope.Context)
+	}
+
+	return
+}
+
+// edit applies edit to the flags of scope and writes them back. The file is locked
+// from reading it to writing it, so concurrent lw processes don't lose each other's
+// edits. The user's flags file is only readable by its owner; a project file is meant to
+// be shared, so it keeps its mode.
+func (scope Scope) edit(create bool, edit func(flags map[string]interface{}) error) (err error) {
+	var vp *viper.Viper
+	if vp, _, err = scope.section(create); err != nil {
+		return
+	}
+	file := vp.ConfigFileUsed()
+
+	var unlock func()
+	if unlock, err = config.Lock(file); err != nil {
+		return
+	}
+	defer unlock()
+	resolvedLayers = map[string][]layer{}
+
+	// read it again, now no other lw process is writing it
+	var key string
+	if vp, key, err = scope.section(create); err != nil {
+		return
+	}
+
+	flags := vp.GetStringMap(key)
+	if err = edit(flags); err != nil {
+		return
+	}
+	vp.Set(key, flags)
+
+	perm := os.FileMode(0600)
+	if scope.Project {
+		perm = projectFileMode
+		if info, statErr := os.Stat(file); statErr == nil {
+			perm = info.Mode().Perm()
+		}
+	}
+
+	err = writeViperConfig(vp, perm)
+
+	return
+}
+
+func getProjectViper(file string) (vp *viper.Viper, err error) {
+	vp = viper.New()
+	vp.SetConfigFile(file)
+	vp.SetConfigType("yaml")
+	if err = vp.ReadInConfig(); err != nil {
+		err = fmt.Errorf("%w: %s", ErrorUnreadable, err)
+	}
+
+	return
+}
+
+func projectContextKey(context string) string {
+	return fmt.Sprintf("%s.%s", ProjectContextsKey, context)
+}
@@ -0,0 +1,146 @@
+package defaults
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cast"
+
+	"github.com/liquidweb/liquidweb-cli/validate"
+)
+
+// registeredFlags maps the name of each command flag registered with Register to
+// its pflag type.
+var registeredFlags = map[string]string{}
+
+// permittedFlag is a flag defaults can be set for.
+type permittedFlag struct {
+	// flagType is the pflag type of the flag, such as int64, string or stringSlice.
+	flagType string
+	// validator is the validate input type from flagOverrides, if any.
+	validator string
+	// project is whether a project file may set the flag; see projectFlagNames.
+	project bool
+}
+
+// Register records a command flag, named after the command path without the root
+// command and the flag name, joined by "_" (such as cloud_server_create_zone).
+// Defaults are permitted for it unless its name is in forbiddenFlagNames. flagType is
+// the pflag type of the flag; values are checked to parse as it unless flagOverrides
+// names a validator for the flag.
+func Register(flag, flagType string) {
+	registeredFlags[flag] = flagType
+}
+
+// FlagType returns the pflag type of a registered flag, or "" when it isn't one.
+func FlagType(flag string) string {
+	return registeredFlags[flag]
+}
+
+func GetPermitted() (permitted []string) {
+	permitted = make([]string, 0, len(registeredFlags))
+	for flag := range registeredFlags {
+		if _, err := permittedFlagOrError(flag); err != nil {
+			continue
+		}
+		permitted = append(permitted, flag)
+	}
+	sort.Strings(permitted)
+
+	return
+}
+
+func permittedFlagOrError(flag string) (permitted permittedFlag, err error) {
+	if flag == "" {
+		err = ErrorInvalidFlagName
+		return
+	}
+
+	var registered bool
+	if permitted.flagType, registered = registeredFlags[flag]; !registered || forbiddenFlagNames[flagName(flag)] {
+		err = fmt.Errorf("%s %w", flag, ErrorForbiddenFlag)
+		return
+	}
+
+	permitted.validator = cast.ToString(flagOverrides[flag]["type"])
+	permitted.project = projectFlagNames[flagName(flag)]
+
+	return
+}
+
+// flagName returns the command flag's own name from a default flag's name, the part
+// after its command.
+func flagName(flag string) string {
+	return flag[strings.LastIndex(flag, "_")+1:]
+}
+
+// nags reports whether running the command of flag without a default set for it
+// should say so.
+func (self permittedFlag) nags() bool {
+	return self.validator != ""
+}
+
+func (self permittedFlag) validate(value interface{}) (err error) {
+	if self.validator != "" {
+		err = validateFlagValue(self.validator, value)
+		return
+	}
+
+	err = validateFlagType(self.flagType, value)
+
+	return
+}
+
+func validateFlagValue(validator string, value interface{}) (err error) {
+	var validateFields map[interface{}]interface{}
+
+	if strings.HasSuffix(validator, "Int64") {
+		validateFields = map[interface{}]interface{}{
+			cast.ToInt64(value): validator,
+		}
+	} else if strings.HasSuffix(validator, "Int") {
+		validateFields = map[interface{}]interface{}{
+			cast.ToInt(value): validator,
+		}
+	} else {
+		validateFields = map[interface{}]interface{}{
+			cast.ToString(value): validator,
+		}
+	}
+
+	err = validate.Validate(validateFields)
+
+	return
+}
+
+// validateFlagType checks value parses as a flag of the pflag type flagType would.
+// Slice values are taken as they are; their elements are checked when the default is
+// applied.
+func validateFlagType(flagType string, value interface{}) (err error) {
+	if _, isList := value.([]interface{}); isList || strings.HasSuffix(flagType, "Slice") ||
+		strings.HasSuffix(flagType, "Array") {
+		return
+	}
+
+	str := cast.ToString(value)
+	switch {
+	case strings.HasPrefix(flagType, "int"):
+		_, err = strconv.ParseInt(str, 0, 64)
+	case strings.HasPrefix(flagType, "uint"):
+		_, err = strconv.ParseUint(str, 0, 64)
+	case strings.HasPrefix(flagType, "float"):
+		_, err = strconv.ParseFloat(str, 64)
+	case flagType == "bool":
+		_, err = strconv.ParseBool(str)
+	case flagType == "duration":
+		_, err = time.ParseDuration(str)
+	}
+	if err != nil {
+		err = fmt.Errorf("%w: [%+v] is not a valid %s", ErrorInvalidValue, value, flagType)
+	}
+
+	return
+}
@@ -0,0 +1,73 @@
+/*
+Copyright © LiquidWeb
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package defaults
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestPermittedFlags(t *testing.T) {
+	for flag, flagType := range map[string]string{
+		"cloud_server_create_zone":           "int64",
+		"cloud_server_create_memory":         "int",
+		"cloud_server_create_password":       "string",
+		"cloud_server_destroy_uniq-id":       "stringSlice",
+		"cloud_server_destroy_targets-file":  "string",
+		"cloud_server_destroy_force":         "bool",
+		"ssh_private-key-file":               "string",
+		"network_load-balancer_create_nodes": "stringSlice",
+	} {
+		Register(flag, flagType)
+	}
+
+	tests := []struct {
+		flag      string
+		permitted bool
+		project   bool
+	}{
+		{"cloud_server_create_zone", true, true},
+		{"cloud_server_create_memory", true, true},
+		{"network_load-balancer_create_nodes", true, false},
+		{"cloud_server_create_password", false, false},
+		{"cloud_server_destroy_uniq-id", false, false},
+		{"cloud_server_destroy_targets-file", false, false},
+		{"cloud_server_destroy_force", false, false},
+		{"ssh_private-key-file", false, false},
+		{"cloud_server_list_unregistered", false, false},
+	}
+
+	for _, test := range tests {
+		permitted, err := permittedFlagOrError(test.flag)
+		if test.permitted != (err == nil) {
+			t.Errorf("%s: err %v, want permitted %v", test.flag, err, test.permitted)
+			continue
+		}
+		if err != nil {
+			if !errors.Is(err, ErrorForbiddenFlag) {
+				t.Errorf("%s: err %v, want %v", test.flag, err, ErrorForbiddenFlag)
+			}
+			continue
+		}
+		if permitted.project != test.project {
+			t.Errorf("%s: project %v, want %v", test.flag, permitted.project, test.project)
+		}
+	}
+
+	if _, err := permittedFlagOrError(""); !errors.Is(err, ErrorInvalidFlagName) {
+		t.Errorf("empty flag: err %v, want %v", err, ErrorInvalidFlagName)
+	}
+}
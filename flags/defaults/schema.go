@@ -0,0 +1,19 @@
+package defaults
+
+import (
+	"github.com/liquidweb/liquidweb-cli/config"
+)
+
+// flagsSchema is the layout of the default flags file. Project files are edited by
+// hand and kept in version control, so they are never migrated.
+var flagsSchema = config.Schema{
+	Name:        "default flags file",
+	VersionPath: []string{VersionKey},
+	Migrations: []config.Migration{
+		{
+			// files from before versioning already have the version 1 layout
+			Description: "record the schema version",
+			Migrate:     func(doc *config.Document) error { return nil },
+		},
+	},
+}
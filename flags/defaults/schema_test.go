@@ -0,0 +1,144 @@
+/*
+Copyright © LiquidWeb
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package defaults
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/liquidweb/liquidweb-cli/config"
+)
+
+// flagsFixtures are default flags files as each schema version of flagsSchema wrote
+// them.
+var flagsFixtures = map[int]string{
+	0: `nags: false
+defaults:
+  prod:
+    cloud_server_create_zone: 27
+    cloud_server_create_template: UBUNTU_2004_UNMANAGED
+`,
+}
+
+func writeFlagsFixture(t *testing.T, content string) (file string) {
+	dir, err := ioutil.TempDir("", "lw-flags-schema")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { os.RemoveAll(dir) })
+
+	file = filepath.Join(dir, "flags.yaml")
+	if err := ioutil.WriteFile(file, []byte(content), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	return
+}
+
+func TestFlagsSchemaMigrate(t *testing.T) {
+	for version := 0; version < flagsSchema.Version(); version++ {
+		fixture, ok := flagsFixtures[version]
+		if !ok {
+			t.Errorf("no fixture for default flags schema version %d", version)
+			continue
+		}
+		file := writeFlagsFixture(t, fixture)
+
+		migrated, err := config.Migrate(file, flagsSchema)
+		if err != nil {
+			t.Fatalf("version %d: %s", version, err)
+		}
+		if !migrated {
+			t.Errorf("version %d: not reported as migrated", version)
+		}
+
+		backup, err := ioutil.ReadFile(fmt.Sprintf("%s.v%d.bak", file, version))
+		if err != nil {
+			t.Fatalf("version %d: reading backup: %s", version, err)
+		}
+		if string(backup) != fixture {
+			t.Errorf("version %d: backup is %q, want the old file %q", version, backup, fixture)
+		}
+
+		doc, err := config.LoadDocument(file)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if node := doc.Lookup(VersionKey); node == nil || node.Value != fmt.Sprint(flagsSchema.Version()) {
+			t.Errorf("version %d: not stamped with version %d: %v", version, flagsSchema.Version(), node)
+		}
+		var flags map[string]interface{}
+		if err := doc.Decode(&flags, DefFlagsKey, "prod"); err != nil {
+			t.Fatal(err)
+		}
+		if flags["cloud_server_create_zone"] != 27 || flags["cloud_server_create_template"] != "UBUNTU_2004_UNMANAGED" {
+			t.Errorf("version %d: flags are %v after upgrading", version, flags)
+		}
+		if node := doc.Lookup(NagsKey); node == nil || node.Value != "false" {
+			t.Errorf("version %d: nags is %v after upgrading", version, node)
+		}
+	}
+}
+
+func TestFlagsSchemaCurrent(t *testing.T) {
+	fixture := fmt.Sprintf("version: %d\ndefaults:\n  prod:\n    cloud_server_create_zone: 27\n",
+		flagsSchema.Version())
+	file := writeFlagsFixture(t, fixture)
+
+	migrated, err := config.Migrate(file, flagsSchema)
+	if err != nil || migrated {
+		t.Errorf("migrated %v, err %v; want it left alone", migrated, err)
+	}
+	assertFlagsUntouched(t, file, fixture)
+}
+
+func TestFlagsSchemaNewer(t *testing.T) {
+	fixture := fmt.Sprintf("version: %d\ndefaults: {}\n", flagsSchema.Version()+1)
+	file := writeFlagsFixture(t, fixture)
+
+	migrated, err := config.Migrate(file, flagsSchema)
+	if !errors.Is(err, config.ErrorNewerSchema) {
+		t.Errorf("err %v, want %v", err, config.ErrorNewerSchema)
+	}
+	if migrated {
+		t.Error("a newer file was reported migrated")
+	}
+	assertFlagsUntouched(t, file, fixture)
+}
+
+// assertFlagsUntouched checks file still holds content, and no backup was made of it.
+func assertFlagsUntouched(t *testing.T, file, content string) {
+	t.Helper()
+
+	got, err := ioutil.ReadFile(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(got) != content {
+		t.Errorf("file changed to %q, want %q", got, content)
+	}
+	backups, err := filepath.Glob(file + ".v*.bak")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(backups) != 0 {
+		t.Errorf("backups %v made", backups)
+	}
+}
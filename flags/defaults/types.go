@@ -24,52 +24,126 @@ func (self AllFlags) String() string {
 	return strings.Join(slice[:], "")
 }
 
-var permittedFlags = map[string]map[string]interface{}{
+// ResolvedFlag is the value of a default flag, and the layer it was found in.
+type ResolvedFlag struct {
+	Flag   string
+	Value  interface{}
+	Source string
+	// Ignored is why the value isn't used, if it isn't.
+	Ignored string
+}
+
+type ResolvedFlags []ResolvedFlag
+
+func (self ResolvedFlags) String() string {
+	var slice []string
+
+	if len(self) == 0 {
+		slice = append(slice, "No default flags apply. Set some with 'default-flags set'.\n")
+	} else {
+		slice = append(slice, "Resolved default flags:\n\n")
+
+		for _, resolved := range self {
+			slice = append(slice, fmt.Sprintf("  Flag: %s\n", resolved.Flag))
+			slice = append(slice, fmt.Sprintf("    Value: %+v\n", resolved.Value))
+			slice = append(slice, fmt.Sprintf("    From: %s\n", resolved.Source))
+			if resolved.Ignored != "" {
+				slice = append(slice, fmt.Sprintf("    Ignored: %s\n", resolved.Ignored))
+			}
+		}
+	}
+
+	return strings.Join(slice[:], "")
+}
+
+// forbiddenFlagNames are the names of command flags defaults are never permitted for,
+// on whichever command registers them. These flags pick what a command acts on, force
+// it to go ahead, or carry a secret: a forgotten default for one would act on, or hand
+// out, something the command line never mentioned.
+var forbiddenFlagNames = map[string]bool{
+	// targets
+	"uniq-id":      true,
+	"targets-file": true,
+	"attach-to":    true,
+	"detach-from":  true,
+	// forcing
+	"force": true,
+	// secrets
+	"password":         true,
+	"token":            true,
+	"secret":           true,
+	"access-key":       true,
+	"ssl-private-key":  true,
+	"private-key-file": true,
+}
+
+// projectFlagNames are the names of the permitted flags a project file may also set.
+// A project file is found by walking up from the working directory, so it may well
+// not be the user's; it's kept to flags choosing where and what to create and how
+// output looks, and can't set anything costing more, or bearing on access to servers.
+var projectFlagNames = map[string]bool{
+	"zone":            true,
+	"region":          true,
+	"region-id":       true,
+	"config-id":       true,
+	"config-category": true,
+	"template":        true,
+	"type":            true,
+	"os":              true,
+	"vcpu":            true,
+	"memory":          true,
+	"diskspace":       true,
+	"strategy":        true,
+	"manage-level":    true,
+	"columns":         true,
+	"sort-by":         true,
+	"filter":          true,
+	"limit":           true,
+	"json":            true,
+	"paginate":        true,
+	"categories":      true,
+	"free-only":       true,
+}
+
+// flagOverrides refines the command flags registered with Register. A "type" names
+// the validate input type values must be, in place of the check inferred from the
+// flag's type; running the flag's command without a default set for it nags about it.
+var flagOverrides = map[string]map[string]interface{}{
 	// cloud network vip create
 	"cloud_network_vip_create_zone": map[string]interface{}{
-		"enabled":   true,
-		"validator": "PositiveInt64",
+		"type": "PositiveInt64",
 	},
 	// cloud private-parent create
 	"cloud_private-parent_create_config-id": map[string]interface{}{
-		"enabled": true,
-		"type":    "PositiveInt64",
+		"type": "PositiveInt64",
 	},
 	"cloud_private-parent_create_zone": map[string]interface{}{
-		"enabled": true,
-		"type":    "PositiveInt64",
+		"type": "PositiveInt64",
 	},
 	// cloud server clone
 	"cloud_server_clone_config-id": map[string]interface{}{
-		"enabled": true,
-		"type":    "PositiveInt64",
+		"type": "PositiveInt64",
 	},
 	// cloud server create
 	"cloud_server_create_zone": map[string]interface{}{
-		"enabled": true,
-		"type":    "PositiveInt64",
+		"type": "PositiveInt64",
 	},
 	"cloud_server_create_template": map[string]interface{}{
-		"enabled": true,
-		"type":    "NonEmptyString",
+		"type": "NonEmptyString",
 	},
 	"cloud_server_create_config-id": map[string]interface{}{
-		"enabled": true,
-		"type":    "PositiveInt64",
+		"type": "PositiveInt64",
 	},
 	// cloud server resize
 	"cloud_server_resize_config-id": map[string]interface{}{
-		"enabled": true,
-		"type":    "PositiveInt64",
+		"type": "PositiveInt64",
 	},
 	// cloud template restore
 	"cloud_template_restore_template": map[string]interface{}{
-		"enabled": true,
-		"type":    "NonEmptyString",
+		"type": "NonEmptyString",
 	},
 	// network ip-pool create
 	"network_ip-pool_create_zone": map[string]interface{}{
-		"enabled": true,
-		"type":    "PositiveInt64",
+		"type": "PositiveInt64",
 	},
 }
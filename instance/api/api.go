@@ -16,39 +16,241 @@ limitations under the License.
 package api
 
 import (
+	"crypto/tls"
+	"crypto/x509"
 	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"path/filepath"
+	"time"
 
 	"github.com/spf13/cast"
 	"github.com/spf13/viper"
 
 	lwApi "github.com/liquidweb/go-lwApi"
+
+	"github.com/liquidweb/liquidweb-cli/secrets"
+	"github.com/liquidweb/liquidweb-cli/types/cmd"
+	"github.com/liquidweb/liquidweb-cli/types/errors"
 )
 
 func New(viper *viper.Viper) (*LwCliApiClient, error) {
+	lwCliApiClient := LwCliApiClient{Viper: viper}
+
+	// LW_* environment variables take the place of the config file's contexts
+	envContext, err := EnvAuthContext()
+	if err != nil {
+		return &LwCliApiClient{}, err
+	}
+	if envContext != nil {
+		lwCliApiClient.EnvContext = envContext
+		lwCliApiClient.detached = true
+		if err := lwCliApiClient.configure(*envContext); err != nil {
+			return &LwCliApiClient{}, err
+		}
+		return &lwCliApiClient, nil
+	}
+
 	// create the object from the current context if there is one. If "auth init" has not yet been ran,
 	// there would be no current context yet.
-	lwCliApiClient := LwCliApiClient{Viper: viper}
 	currentContext := viper.GetString("liquidweb.api.current_context")
 	if currentContext != "" {
-		apiUsername := viper.GetString(fmt.Sprintf("liquidweb.api.contexts.%s.username", currentContext))
-		apiPassword := viper.GetString(fmt.Sprintf("liquidweb.api.contexts.%s.password", currentContext))
-
-		lwApiCfg := lwApi.LWAPIConfig{
-			Username: &apiUsername,
-			Password: &apiPassword,
-			Url:      viper.GetString(fmt.Sprintf("liquidweb.api.contexts.%s.url", currentContext)),
-			Insecure: viper.GetBool(fmt.Sprintf("liquidweb.api.contexts.%s.insecure", currentContext)),
-			Timeout: cast.ToUint(viper.GetInt(fmt.Sprintf("liquidweb.api.contexts.%s.timeout",
-				currentContext))),
+		settingKey := func(setting string) string {
+			return fmt.Sprintf("liquidweb.api.contexts.%s.%s", currentContext, setting)
 		}
 
-		lwApiClient, err := lwApi.New(&lwApiCfg)
-		if err != nil {
+		authContext := cmdTypes.AuthContext{
+			CurrentContext:  true,
+			ContextName:     currentContext,
+			Username:        viper.GetString(settingKey("username")),
+			Password:        viper.GetString(settingKey("password")),
+			AuthType:        viper.GetString(settingKey("authtype")),
+			Token:           viper.GetString(settingKey("token")),
+			TokenExpires:    viper.GetString(settingKey("tokenexpires")),
+			TokenRotated:    viper.GetString(settingKey("tokenrotated")),
+			PasswordStore:   viper.GetString(settingKey("passwordstore")),
+			PasswordCommand: viper.GetString(settingKey("passwordcommand")),
+			Url:             viper.GetString(settingKey("url")),
+			Insecure:        viper.GetBool(settingKey("insecure")),
+			Timeout:         viper.GetInt(settingKey("timeout")),
+			Proxy:           viper.GetString(settingKey("proxy")),
+			CaFile:          viper.GetString(settingKey("cafile")),
+			ClientCert:      viper.GetString(settingKey("clientcert")),
+			ClientKey:       viper.GetString(settingKey("clientkey")),
+			TlsServerName:   viper.GetString(settingKey("tlsservername")),
+		}
+		if err := lwCliApiClient.configure(authContext); err != nil {
 			return &LwCliApiClient{}, err
 		}
+	}
 
-		lwCliApiClient.LwApiClient = lwApiClient
+	return &lwCliApiClient, nil
+}
+
+// NewForAuthContext returns a client making calls as authContext rather than the config
+// file's current context, such as to verify credentials before they are saved. Its
+// responses are never cached.
+func NewForAuthContext(authContext cmdTypes.AuthContext) (*LwCliApiClient, error) {
+	lwCliApiClient := LwCliApiClient{detached: true}
+	if err := lwCliApiClient.configure(authContext); err != nil {
+		return &LwCliApiClient{}, err
 	}
 
 	return &lwCliApiClient, nil
 }
+
+// configure sets the client up to make calls as the given auth context.
+func (x *LwCliApiClient) configure(authContext cmdTypes.AuthContext) error {
+	apiUsername := authContext.Username
+	apiSecret := authContext.Secret()
+
+	// a secret kept in a store is only looked up once a call needs it, so commands
+	// not calling the api never prompt for a vault passphrase. lwApi.New insists on a
+	// password or token though; the placeholder given to it is never sent.
+	if authContext.PasswordStore != "" && authContext.PasswordStore != secrets.Plaintext {
+		x.lookupSecret = lazySecret(authContext.ContextName, authContext.PasswordStore,
+			authContext.PasswordCommand)
+		apiSecret = authContext.PasswordStore
+	}
+	// likewise a context without a secret yet, such as one imported without it, only
+	// fails the calls needing it rather than every command.
+	if apiSecret == "" {
+		x.lookupSecret = func() (string, error) {
+			return "", fmt.Errorf("%w Raw error: context [%s] has no password or token set",
+				errorTypes.PasswordUnavailable, authContext.ContextName)
+		}
+		apiSecret = "unset"
+	}
+
+	lwApiCfg := lwApi.LWAPIConfig{
+		Url:      authContext.Url,
+		Insecure: authContext.Insecure,
+		Timeout:  cast.ToUint(authContext.Timeout),
+	}
+	if authContext.UsesToken() {
+		lwApiCfg.Token = &apiSecret
+	} else {
+		lwApiCfg.Username = &apiUsername
+		lwApiCfg.Password = &apiSecret
+	}
+
+	lwApiClient, err := lwApi.New(&lwApiCfg)
+	if err != nil {
+		return err
+	}
+
+	x.LwApiClient = lwApiClient
+	x.AuthContext = &authContext
+	// lwApi.New fills in defaults (such as Timeout), so hold onto the processed config
+	// for making our own context aware requests.
+	x.config = lwApiCfg
+
+	// a bad transport setting is reported when a call is made rather than here, so the
+	// auth commands needed to correct it keep working.
+	x.httpClient, x.transportErr = NewHttpClient(TransportSettings{
+		Timeout:       lwApiCfg.Timeout,
+		Insecure:      lwApiCfg.Insecure,
+		Proxy:         authContext.Proxy,
+		CaFile:        authContext.CaFile,
+		ClientCert:    authContext.ClientCert,
+		ClientKey:     authContext.ClientKey,
+		TlsServerName: authContext.TlsServerName,
+	})
+
+	return nil
+}
+
+// lazySecret returns a function looking up the password or token of an auth context
+// in its store on first use.
+func lazySecret(context, store, command string) func() (string, error) {
+	var (
+		looked bool
+		secret string
+		err    error
+	)
+
+	return func() (string, error) {
+		if !looked {
+			secret, err = secrets.Password(context, store, command, "")
+			if err != nil {
+				err = fmt.Errorf("%w Raw error: %s", errorTypes.PasswordUnavailable, err)
+			}
+			looked = true
+		}
+
+		return secret, err
+	}
+}
+
+// TransportSettings are the per auth context settings for the HTTP transport API
+// calls are made over.
+type TransportSettings struct {
+	// Timeout is in seconds.
+	Timeout  uint
+	Insecure bool
+	// Proxy is the URL of an HTTP(S) proxy. When empty, the HTTPS_PROXY and NO_PROXY
+	// environment variables are honored.
+	Proxy string
+	// CaFile is a PEM bundle of CAs trusted in addition to the system ones.
+	CaFile string
+	// ClientCert and ClientKey are PEM files presented for TLS client authentication.
+	ClientCert string
+	ClientKey  string
+	// TlsServerName overrides the server name the API certificate is verified against.
+	TlsServerName string
+}
+
+// NewHttpClient returns the HTTP client API calls are made with, or an error when
+// settings can't be applied.
+func NewHttpClient(settings TransportSettings) (*http.Client, error) {
+	tlsConfig := &tls.Config{
+		InsecureSkipVerify: settings.Insecure,
+		ServerName:         settings.TlsServerName,
+	}
+
+	if settings.CaFile != "" {
+		pem, err := ioutil.ReadFile(filepath.Clean(settings.CaFile))
+		if err != nil {
+			return nil, fmt.Errorf("error reading ca-file [%s]: %s", settings.CaFile, err)
+		}
+		// start from the system pool so the public API stays reachable
+		pool, err := x509.SystemCertPool()
+		if err != nil || pool == nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("ca-file [%s] has no PEM encoded certificates", settings.CaFile)
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	if settings.ClientCert != "" || settings.ClientKey != "" {
+		if settings.ClientCert == "" || settings.ClientKey == "" {
+			return nil, fmt.Errorf("client-cert and client-key must be set together")
+		}
+		cert, err := tls.LoadX509KeyPair(filepath.Clean(settings.ClientCert), filepath.Clean(settings.ClientKey))
+		if err != nil {
+			return nil, fmt.Errorf("error loading client-cert [%s] and client-key [%s]: %s",
+				settings.ClientCert, settings.ClientKey, err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	// keep the default transport's proxy-from-environment, dial and idle behavior
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = tlsConfig
+
+	if settings.Proxy != "" {
+		proxyUrl, err := url.Parse(settings.Proxy)
+		if err != nil || proxyUrl.Scheme == "" || proxyUrl.Host == "" {
+			return nil, fmt.Errorf("proxy [%s] must be a URL such as http://proxy.example.com:3128", settings.Proxy)
+		}
+		transport.Proxy = http.ProxyURL(proxyUrl)
+	}
+
+	return &http.Client{
+		Timeout:   time.Duration(settings.Timeout) * time.Second,
+		Transport: transport,
+	}, nil
+}
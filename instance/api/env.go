@@ -0,0 +1,95 @@
+/*
+Copyright © LiquidWeb
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package api
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/liquidweb/liquidweb-cli/types/cmd"
+	"github.com/liquidweb/liquidweb-cli/types/errors"
+)
+
+// Environment variables making up an ephemeral auth context, for running without a
+// config file such as in CI. Either LW_TOKEN, or LW_USERNAME and LW_PASSWORD, are
+// required; the rest are optional.
+const (
+	EnvUsername = "LW_USERNAME"
+	EnvPassword = "LW_PASSWORD"
+	EnvToken    = "LW_TOKEN"
+	EnvApiUrl   = "LW_API_URL"
+	EnvTimeout  = "LW_TIMEOUT"
+	// EnvContext names the ephemeral context, e.g. in cache entries and 'auth get-context'.
+	// Without an ephemeral context, it picks a configured one like --use-context.
+	EnvContext = "LW_CONTEXT"
+)
+
+// Defaults for the optional environment variables of an ephemeral auth context.
+const (
+	DefaultEnvContextName = "environment"
+	DefaultApiUrl         = "https://api.liquidweb.com"
+	DefaultTimeout        = 90
+)
+
+// EnvAuthContext returns the ephemeral auth context given by the LW_* environment
+// variables, or nil when neither LW_TOKEN nor LW_USERNAME and LW_PASSWORD are set.
+// It is never written to the config file.
+func EnvAuthContext() (*cmdTypes.AuthContext, error) {
+	username := os.Getenv(EnvUsername)
+	password := os.Getenv(EnvPassword)
+	token := os.Getenv(EnvToken)
+	if username == "" && password == "" && token == "" {
+		return nil, nil
+	}
+	if token != "" && password != "" {
+		return nil, fmt.Errorf("%w: only one of %s and %s may be set", errorTypes.LwCliInputError, EnvToken,
+			EnvPassword)
+	}
+	if token == "" && (username == "" || password == "") {
+		return nil, fmt.Errorf("%w: %s and %s must be set together", errorTypes.LwCliInputError, EnvUsername,
+			EnvPassword)
+	}
+
+	authContext := &cmdTypes.AuthContext{
+		CurrentContext: true,
+		ContextName:    os.Getenv(EnvContext),
+		Username:       username,
+		Password:       password,
+		Url:            os.Getenv(EnvApiUrl),
+		Timeout:        DefaultTimeout,
+	}
+	if token != "" {
+		authContext.AuthType = cmdTypes.AuthTypeToken
+		authContext.Token = token
+	}
+	if authContext.ContextName == "" {
+		authContext.ContextName = DefaultEnvContextName
+	}
+	if authContext.Url == "" {
+		authContext.Url = DefaultApiUrl
+	}
+	if timeout := os.Getenv(EnvTimeout); timeout != "" {
+		seconds, err := strconv.Atoi(timeout)
+		if err != nil || seconds <= 0 {
+			return nil, fmt.Errorf("%w: %s must be a positive number of seconds, not [%s]",
+				errorTypes.LwCliInvalidFlagValue, EnvTimeout, timeout)
+		}
+		authContext.Timeout = seconds
+	}
+
+	return authContext, nil
+}
@@ -17,32 +17,237 @@ limitations under the License.
 package api
 
 import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"sync/atomic"
+	"time"
 
 	lwApi "github.com/liquidweb/go-lwApi"
 	"github.com/spf13/viper"
 
+	"github.com/liquidweb/liquidweb-cli/cache"
+	"github.com/liquidweb/liquidweb-cli/types/cmd"
 	"github.com/liquidweb/liquidweb-cli/types/errors"
 )
 
+// inFlight counts the api requests being made.
+var inFlight int32
+
+// InFlight reports whether an api request is being made.
+func InFlight() bool {
+	return atomic.LoadInt32(&inFlight) > 0
+}
+
 type LwCliApiClient struct {
 	LwApiClient *lwApi.Client
 	Viper       *viper.Viper
+	// NoCache bypasses the on-disk response cache for catalog methods.
+	NoCache bool
+	// Context is used by Call for cancellation and deadlines. When nil,
+	// context.Background() is used.
+	Context context.Context
+	// EnvContext is the ephemeral auth context calls are made as when it is given by
+	// LW_* environment variables, in place of the config file's current context.
+	EnvContext *cmdTypes.AuthContext
+	// AuthContext is the auth context calls are made as; nil before "auth init".
+	AuthContext *cmdTypes.AuthContext
+
+	// detached is set when calls are made as AuthContext without consulting the config file.
+	detached bool
+
+	config     lwApi.LWAPIConfig
+	httpClient *http.Client
+	// transportErr is set when the context's transport settings couldn't be applied.
+	transportErr error
+	// lookupSecret is set when the context's password or token is kept in a password store.
+	lookupSecret func() (string, error)
 }
 
 func (x LwCliApiClient) Call(method string, params interface{}) (got interface{}, err error) {
-	if err = x.Viper.ReadInConfig(); err != nil {
-		err = fmt.Errorf("%w Raw error: %s", errorTypes.ErrorReadingConfig, err)
+	ctx := x.Context
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	got, err = x.CallContext(ctx, method, params)
+
+	return
+}
+
+// CallContext is like Call, but aborts the in-flight request when ctx is cancelled
+// or its deadline passes.
+func (x LwCliApiClient) CallContext(ctx context.Context, method string, params interface{}) (got interface{}, err error) {
+	var currentContext string
+	if x.detached {
+		// there may well be no config file to read
+		currentContext = x.AuthContext.ContextName
+	} else {
+		if err = x.Viper.ReadInConfig(); err != nil {
+			err = fmt.Errorf("%w Raw error: %s", errorTypes.ErrorReadingConfig, err)
+			return
+		}
+
+		currentContext = x.Viper.GetString("liquidweb.api.current_context")
+		if currentContext == "" {
+			err = errorTypes.NoCurrentContext
+			return
+		}
+	}
+
+	// an ephemeral context leaves nothing behind on disk, cache entries included
+	if x.detached {
+		got, err = x.call(ctx, method, params)
+		return
+	}
+	if x.NoCache || !cache.Cacheable(method) {
+		if got, err = x.call(ctx, method, params); err == nil {
+			// the cache is best effort; failing to invalidate it shouldn't fail the call
+			_ = cache.Invalidate(method)
+		}
 		return
 	}
 
-	currentContext := x.Viper.GetString("liquidweb.api.current_context")
-	if currentContext == "" {
-		err = errorTypes.NoCurrentContext
+	account := cache.Account{Context: currentContext, Url: x.config.Url}
+	if x.config.Username != nil {
+		account.Username = *x.config.Username
+	}
+	got, err = x.cachedCall(ctx, account, method, params)
+
+	return
+}
+
+func (x LwCliApiClient) cachedCall(ctx context.Context, account cache.Account, method string,
+	params interface{}) (got interface{}, err error) {
+	ttl := cache.DefaultTtl
+	if x.Viper.IsSet(cache.CacheTtlKey) {
+		ttl = time.Duration(x.Viper.GetInt(cache.CacheTtlKey)) * time.Second
+	}
+
+	key, keyErr := cache.Key(account, method, params)
+	if keyErr == nil {
+		var found bool
+		if got, found = cache.Get(key, ttl); found {
+			return
+		}
+	}
+
+	got, err = x.call(ctx, method, params)
+	if err != nil || keyErr != nil {
 		return
 	}
 
-	got, err = x.LwApiClient.Call(method, params)
+	// the cache is best effort; failing to store an entry shouldn't fail the call
+	_ = cache.Set(key, method, got)
 
 	return
 }
+
+// call performs the same request lwApi.Client.Call would, but with ctx attached so
+// it can be interrupted.
+func (x LwCliApiClient) call(ctx context.Context, method string, params interface{}) (got interface{}, err error) {
+	if err = ctx.Err(); err != nil {
+		err = fmt.Errorf("calling method [%s] aborted: %w", method, err)
+		return
+	}
+	if x.transportErr != nil {
+		err = fmt.Errorf("%w Raw error: %s", errorTypes.InvalidTransportSettings, x.transportErr)
+		return
+	}
+
+	// api wants the "params" prefix key.
+	var encodedArgs []byte
+	encodedArgs, err = json.Marshal(map[string]interface{}{"params": params})
+	if err != nil {
+		return
+	}
+
+	var req *http.Request
+	req, err = http.NewRequest("POST", fmt.Sprintf("%s/%s", x.config.Url, method), bytes.NewReader(encodedArgs))
+	if err != nil {
+		return
+	}
+	req = req.WithContext(ctx)
+
+	if x.config.Token != nil {
+		var token string
+		if token, err = x.secret(*x.config.Token); err != nil {
+			return
+		}
+		req.Header.Add("Authorization", "Bearer "+token)
+	} else if x.config.Username != nil && x.config.Password != nil {
+		var password string
+		if password, err = x.secret(*x.config.Password); err != nil {
+			return
+		}
+		req.SetBasicAuth(*x.config.Username, password)
+	} else {
+		err = fmt.Errorf("No valid credential provided")
+		return
+	}
+
+	atomic.AddInt32(&inFlight, 1)
+	defer atomic.AddInt32(&inFlight, -1)
+
+	resp, err := x.httpClient.Do(req)
+	if err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			err = fmt.Errorf("calling method [%s] aborted: %w", method, ctxErr)
+		} else if isTransientTransportError(err) {
+			err = fmt.Errorf("%w Raw error: %s", errorTypes.LwApiTransientError, err)
+		}
+		return
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != 200 {
+		err = errorTypes.NewLwApiStatusError(method, resp.StatusCode)
+		return
+	}
+
+	var body []byte
+	body, err = ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return
+	}
+
+	var raw map[string]interface{}
+	if err = json.Unmarshal(body, &raw); err == nil {
+		if errorClass, exists := raw["error_class"]; exists && fmt.Sprintf("%s", errorClass) != "" {
+			err = errorTypes.NewLwApiError(method, fmt.Sprintf("%s", errorClass),
+				fmt.Sprintf("%s", raw["full_message"]), fmt.Sprintf("%s", raw["error"]))
+			return
+		}
+	}
+
+	err = json.Unmarshal(body, &got)
+
+	return
+}
+
+// secret returns the password or token to authenticate with: value, unless the
+// context keeps it in a password store.
+func (x LwCliApiClient) secret(value string) (string, error) {
+	if x.lookupSecret == nil {
+		return value, nil
+	}
+
+	return x.lookupSecret()
+}
+
+// isTransientTransportError reports whether err is a network failure or timeout reaching
+// the api, which retrying may get past.
+func isTransientTransportError(err error) bool {
+	var opErr *net.OpError
+	if errors.As(err, &opErr) {
+		return true
+	}
+
+	var netErr net.Error
+	return errors.As(err, &netErr) && netErr.Timeout()
+}
@@ -16,54 +16,16 @@ limitations under the License.
 package instance
 
 import (
-	"bytes"
-	"encoding/json"
 	"fmt"
+	"strings"
+	"unicode"
 
 	"github.com/spf13/viper"
 
 	"github.com/liquidweb/liquidweb-cli/types/cmd"
+	"github.com/liquidweb/liquidweb-cli/types/errors"
 )
 
-func (client *Client) RemoveContext(context string) error {
-	// review this function once Unset (or similar) in viper is available
-	// https://github.com/spf13/viper/pull/519
-	if context == "" {
-		return fmt.Errorf("context cannot be empty")
-	}
-
-	currentContext := client.Viper.GetString("liquidweb.api.current_context")
-	if context == currentContext {
-		return fmt.Errorf("cannot remove context currently set as current context")
-	}
-
-	if err := ValidateContext(context, client.Viper); err != nil {
-		return fmt.Errorf("context %s doesnt exist, cannot remove", context)
-	}
-
-	// save current config into a map, then delete requested context
-	cfgMap := client.Viper.AllSettings()
-	delete(cfgMap["liquidweb"].(map[string]interface{})["api"].(map[string]interface{})["contexts"].(map[string]interface{}), context)
-
-	// json encode modified map
-	encodedCfg, err := json.MarshalIndent(cfgMap, "", " ")
-	if err != nil {
-		return err
-	}
-
-	// read newly encoded config back into viper
-	if err := client.Viper.ReadConfig(bytes.NewBuffer(encodedCfg)); err != nil {
-		return err
-	}
-
-	// write the new viper configuration to file
-	if err := client.Viper.WriteConfig(); err != nil {
-		return err
-	}
-
-	return nil
-}
-
 func ValidateContext(wantedContext string, vp *viper.Viper) error {
 	var isValid bool
 	contexts := vp.GetStringMap("liquidweb.api.contexts")
@@ -85,3 +47,26 @@ func ValidateContext(wantedContext string, vp *viper.Viper) error {
 
 	return nil
 }
+
+// ValidateContextName checks name can be stored in the config file and picked with
+// --use-context unambiguously. Config keys are split on ".", so a name holding one
+// would be read back as nested settings, and a name starting with "-" reads as a flag.
+func ValidateContextName(name string) error {
+	var problem string
+	switch {
+	case strings.TrimSpace(name) == "":
+		return fmt.Errorf("%w: context name cannot be empty", errorTypes.LwCliInvalidFlagValue)
+	case strings.TrimSpace(name) != name:
+		problem = "cannot start or end with whitespace"
+	case strings.Contains(name, "."):
+		problem = "cannot contain '.'"
+	case strings.HasPrefix(name, "-"):
+		problem = "cannot start with '-'"
+	case strings.IndexFunc(name, unicode.IsControl) != -1:
+		problem = "cannot contain control characters"
+	default:
+		return nil
+	}
+
+	return fmt.Errorf("%w: context name [%s] %s", errorTypes.LwCliInvalidFlagValue, name, problem)
+}
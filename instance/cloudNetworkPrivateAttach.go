@@ -57,10 +57,8 @@ func (self *Client) CloudNetworkPrivateAttach(params *CloudNetworkPrivateAttachP
 			continue
 		}
 
-		apiArgs := map[string]interface{}{"uniq_id": uniqId}
-
-		var attachedDetails apiTypes.CloudNetworkPrivateIsAttachedResponse
-		if err = self.CallLwApiInto("bleed/network/private/isattached", apiArgs, &attachedDetails); err != nil {
+		var attachedDetails *apiTypes.CloudNetworkPrivateIsAttachedResponse
+		if attachedDetails, err = self.Api.Network.Private.IsAttached(self.Context(), uniqId); err != nil {
 			return
 		}
 		if attachedDetails.IsAttached {
@@ -68,8 +66,8 @@ func (self *Client) CloudNetworkPrivateAttach(params *CloudNetworkPrivateAttachP
 			return
 		}
 
-		var details apiTypes.CloudNetworkPrivateAttachResponse
-		if err = self.CallLwApiInto("bleed/network/private/attach", apiArgs, &details); err != nil {
+		var details *apiTypes.CloudNetworkPrivateAttachResponse
+		if details, err = self.Api.Network.Private.Attach(self.Context(), uniqId); err != nil {
 			return
 		}
 
@@ -57,10 +57,8 @@ func (self *Client) CloudNetworkPrivateDetach(params *CloudNetworkPrivateDetachP
 			continue
 		}
 
-		apiArgs := map[string]interface{}{"uniq_id": uniqId}
-
-		var attachedDetails apiTypes.CloudNetworkPrivateIsAttachedResponse
-		if err = self.CallLwApiInto("bleed/network/private/isattached", apiArgs, &attachedDetails); err != nil {
+		var attachedDetails *apiTypes.CloudNetworkPrivateIsAttachedResponse
+		if attachedDetails, err = self.Api.Network.Private.IsAttached(self.Context(), uniqId); err != nil {
 			return
 		}
 		if !attachedDetails.IsAttached {
@@ -68,8 +66,8 @@ func (self *Client) CloudNetworkPrivateDetach(params *CloudNetworkPrivateDetachP
 			return
 		}
 
-		var details apiTypes.CloudNetworkPrivateDetachResponse
-		if err = self.CallLwApiInto("bleed/network/private/detach", apiArgs, &details); err != nil {
+		var details *apiTypes.CloudNetworkPrivateDetachResponse
+		if details, err = self.Api.Network.Private.Detach(self.Context(), uniqId); err != nil {
 			return
 		}
 
@@ -19,7 +19,7 @@ import (
 	"bytes"
 	"fmt"
 
-	"github.com/liquidweb/liquidweb-cli/types/api"
+	lwClient "github.com/liquidweb/liquidweb-cli/client"
 	"github.com/liquidweb/liquidweb-cli/validate"
 )
 
@@ -57,19 +57,19 @@ func (self *Client) CloudNetworkPublicAdd(params *CloudNetworkPublicAddParams) (
 		return
 	}
 
-	apiArgs := map[string]interface{}{
-		"configure_ips": params.ConfigureIps,
-		"uniq_id":       params.UniqId,
+	apiArgs := &lwClient.NetworkIpAddRequest{
+		ConfigureIps: params.ConfigureIps,
+		UniqId:       params.UniqId,
 	}
 	if params.NewIps != 0 {
-		apiArgs["ip_count"] = params.NewIps
+		apiArgs.IpCount = params.NewIps
 		validateFields := map[interface{}]interface{}{params.NewIps: "PositiveInt64"}
 		if err = validate.Validate(validateFields); err != nil {
 			return
 		}
 	}
 	if len(params.PoolIps) != 0 {
-		apiArgs["pool_ips"] = params.PoolIps
+		apiArgs.PoolIps = params.PoolIps
 		validateFields := map[interface{}]interface{}{}
 		for _, ip := range params.PoolIps {
 			validateFields[ip] = "IP"
@@ -80,14 +80,14 @@ func (self *Client) CloudNetworkPublicAdd(params *CloudNetworkPublicAddParams) (
 	}
 
 	if params.NewIp6s != 0 {
-		apiArgs["ip6_count"] = params.NewIp6s
+		apiArgs.Ip6Count = params.NewIp6s
 		validateFields := map[interface{}]interface{}{params.NewIps: "PositiveInt64"}
 		if err = validate.Validate(validateFields); err != nil {
 			return
 		}
 	}
 	if len(params.Pool6Ips) != 0 {
-		apiArgs["pool6_ips"] = params.Pool6Ips
+		apiArgs.Pool6Ips = params.Pool6Ips
 		validateFields := map[interface{}]interface{}{}
 		for _, ip := range params.Pool6Ips {
 			validateFields[ip] = "CIDR"
@@ -97,8 +97,8 @@ func (self *Client) CloudNetworkPublicAdd(params *CloudNetworkPublicAddParams) (
 		}
 	}
 
-	var details apiTypes.NetworkIpAdd
-	if err = self.CallLwApiInto("bleed/network/ip/add", apiArgs, &details); err != nil {
+	details, err := self.Api.Network.Ip.Add(self.Context(), apiArgs)
+	if err != nil {
 		return
 	}
 
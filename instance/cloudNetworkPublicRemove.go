@@ -20,6 +20,7 @@ import (
 	"errors"
 	"fmt"
 
+	lwClient "github.com/liquidweb/liquidweb-cli/client"
 	"github.com/liquidweb/liquidweb-cli/types/api"
 	"github.com/liquidweb/liquidweb-cli/validate"
 )
@@ -54,9 +55,9 @@ func (self *Client) CloudNetworkPublicRemove(params *CloudNetworkPublicRemovePar
 		return
 	}
 
-	apiArgs := map[string]interface{}{
-		"configure_ips": params.ConfigureIps,
-		"uniq_id":       params.UniqId,
+	apiArgs := &lwClient.NetworkIpRemoveRequest{
+		ConfigureIps: params.ConfigureIps,
+		UniqId:       params.UniqId,
 	}
 
 	var b bytes.Buffer
@@ -69,9 +70,9 @@ func (self *Client) CloudNetworkPublicRemove(params *CloudNetworkPublicRemovePar
 			continue
 		}
 
-		var details apiTypes.NetworkIpRemove
-		apiArgs["ip"] = ip
-		if err = self.CallLwApiInto("bleed/network/ip/remove", apiArgs, &details); err != nil {
+		var details *apiTypes.NetworkIpRemove
+		apiArgs.Ip = ip
+		if details, err = self.Api.Network.Ip.Remove(self.Context(), apiArgs); err != nil {
 			return
 		}
 
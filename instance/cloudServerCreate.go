@@ -21,6 +21,7 @@ import (
 
 	"github.com/spf13/cast"
 
+	lwClient "github.com/liquidweb/liquidweb-cli/client"
 	"github.com/liquidweb/liquidweb-cli/types/api"
 	"github.com/liquidweb/liquidweb-cli/utils"
 	"github.com/liquidweb/liquidweb-cli/validate"
@@ -168,69 +169,64 @@ func (ci *Client) CloudServerCreate(params *CloudServerCreateParams) (string, er
 	}
 
 	// buildout args for bleed/server/create
-	createArgs := map[string]interface{}{
-		"domain":   params.Hostname,
-		"pool_ips": params.PoolIps,
-		"new_ips":  params.Ips,
-		"zone":     params.Zone,
-		"password": params.Password,
-		"features": map[string]interface{}{
-			"Bandwidth": params.Bandwidth,
-			"ConfigId":  params.ConfigId,
-			"ExtraIp": map[string]interface{}{
-				"value": params.Ips,
-				"count": 0,
+	createArgs := &lwClient.ServerCreateRequest{
+		Domain:   params.Hostname,
+		PoolIps:  params.PoolIps,
+		NewIps:   params.Ips,
+		Zone:     params.Zone,
+		Password: params.Password,
+		Features: lwClient.ServerCreateFeatures{
+			Bandwidth: params.Bandwidth,
+			ConfigId:  params.ConfigId,
+			ExtraIp: lwClient.ServerCreateFeatureOption{
+				Value: params.Ips,
+				Count: 0,
 			},
-			"LiquidWebBackupPlan": cloudBackupPlan,
+			LiquidWebBackupPlan: cloudBackupPlan,
 		},
 	}
 
 	if len(params.Pool6Ips) > 0 {
-		createArgs["pool6_ips"] = params.Pool6Ips
+		createArgs.Pool6Ips = params.Pool6Ips
 	}
 	if params.Ip6s > 0 {
-		createArgs["new_ip6s"] = params.Ip6s
+		createArgs.NewIp6s = params.Ip6s
 	}
 
 	var isWindows bool
 	if params.Template != "" {
-		createArgs["features"].(map[string]interface{})["Template"] = params.Template
+		createArgs.Features.Template = params.Template
 		if strings.Contains(strings.ToUpper(params.Template), "WINDOWS") {
 			isWindows = true
 		}
 	}
 	if params.BackupId != -1 {
 		// check backup and see if its windows
-		apiArgs := map[string]interface{}{"id": params.BackupId}
-		var details apiTypes.CloudBackupDetails
-		err := ci.CallLwApiInto("bleed/storm/backup/details", apiArgs, &details)
+		details, err := ci.Api.Storm.Backup.Details(ci.Context(), int64(params.BackupId))
 		if err != nil {
 			return "", err
 		}
 		if strings.Contains(strings.ToUpper(details.Template), "WINDOWS") {
 			isWindows = true
 		}
-		createArgs["backup_id"] = params.BackupId
+		createArgs.BackupId = params.BackupId
 	}
 	if params.ImageId != -1 {
 		// check image and see if its windows
-		apiArgs := map[string]interface{}{"id": params.ImageId}
-		var details apiTypes.CloudImageDetails
-		err := ci.CallLwApiInto("bleed/storm/image/details", apiArgs, &details)
+		details, err := ci.Api.Storm.Image.Details(ci.Context(), int64(params.ImageId))
 		if err != nil {
 			return "", err
 		}
 		if strings.Contains(strings.ToUpper(details.Template), "WINDOWS") {
 			isWindows = true
 		}
-		createArgs["image_id"] = params.ImageId
+		createArgs.ImageId = params.ImageId
 	}
 
 	// when creating with a config-id, adjust the Type param for bare-metal types if blatantly wrong
-	var configDetails apiTypes.CloudConfigDetails
+	configDetails := &apiTypes.CloudConfigDetails{}
 	if params.ConfigId > 0 {
-		if err := ci.CallLwApiInto("bleed/storm/config/details",
-			map[string]interface{}{"id": params.ConfigId}, &configDetails); err != nil {
+		if configDetails, err = ci.Api.Storm.Config.Details(ci.Context(), int64(params.ConfigId)); err != nil {
 			return "", err
 		}
 		if configDetails.Category == "bare-metal" {
@@ -249,8 +245,8 @@ func (ci *Client) CloudServerCreate(params *CloudServerCreateParams) (string, er
 		if params.WinAv == "" {
 			params.WinAv = "None"
 		}
-		createArgs["features"].(map[string]interface{})["WinAV"] = params.WinAv
-		createArgs["features"].(map[string]interface{})["WindowsLicense"] = "Windows"
+		createArgs.Features.WinAV = params.WinAv
+		createArgs.Features.WindowsLicense = "Windows"
 		if params.Type == "SS.VPS" {
 			params.Type = "SS.VPS.WIN"
 		}
@@ -265,38 +261,39 @@ func (ci *Client) CloudServerCreate(params *CloudServerCreateParams) (string, er
 			// private parent, use vcpu flag
 			coreCnt = params.Vcpu
 		}
-		createArgs["features"].(map[string]interface{})["MsSQL"] = map[string]interface{}{
-			"value": params.MsSql,
-			"count": coreCnt,
+		createArgs.Features.MsSQL = &lwClient.ServerCreateFeatureOption{
+			Value: params.MsSql,
+			Count: coreCnt,
 		}
 	}
 
-	createArgs["type"] = params.Type
+	createArgs.Type = params.Type
 
 	if params.PrivateParent != "" {
-		createArgs["parent"] = params.PrivateParent
-		createArgs["vcpu"] = params.Vcpu
-		createArgs["diskspace"] = params.Diskspace
-		createArgs["memory"] = params.Memory
+		createArgs.Parent = params.PrivateParent
+		createArgs.Vcpu = params.Vcpu
+		createArgs.Diskspace = params.Diskspace
+		createArgs.Memory = params.Memory
 	}
 
 	if cloudBackupPlan == "Quota" {
-		createArgs["features"].(map[string]interface{})["BackupQuota"] = params.BackupQuota
+		backupQuota := params.BackupQuota
+		createArgs.Features.BackupQuota = &backupQuota
 	} else if cloudBackupPlan == "Daily" {
-		createArgs["features"].(map[string]interface{})["BackupDay"] = map[string]int{
-			"value":     1,
-			"num_units": params.BackupDays,
+		createArgs.Features.BackupDay = &lwClient.ServerCreateFeatureBackups{
+			Value:    1,
+			NumUnits: params.BackupDays,
 		}
 	}
 
 	if params.PublicSshKey != "" {
-		createArgs["public_ssh_key"] = params.PublicSshKey
+		createArgs.PublicSshKey = params.PublicSshKey
 	}
 
-	result, err := ci.LwCliApiClient.Call("bleed/server/create", createArgs)
+	result, err := ci.Api.Server.Create(ci.Context(), createArgs)
 	if err != nil {
 		return "", err
 	}
 
-	return cast.ToString(result.(map[string]interface{})["uniq_id"]), nil
+	return result.UniqId, nil
 }
@@ -18,7 +18,6 @@ package instance
 import (
 	"fmt"
 
-	"github.com/liquidweb/liquidweb-cli/types/api"
 	"github.com/liquidweb/liquidweb-cli/validate"
 )
 
@@ -49,18 +48,8 @@ func (self *Client) CloudServerReboot(params *CloudServerRebootParams) (result s
 		return
 	}
 
-	force := 0
-	if params.Force {
-		force = 1
-	}
-
-	rebootArgs := map[string]interface{}{
-		"uniq_id": params.UniqId,
-		"force":   force,
-	}
-
-	var resp apiTypes.CloudServerRebootResponse
-	if err = self.CallLwApiInto("bleed/storm/server/reboot", rebootArgs, &resp); err != nil {
+	resp, err := self.Api.Storm.Server.Reboot(self.Context(), params.UniqId, params.Force)
+	if err != nil {
 		return
 	}
 
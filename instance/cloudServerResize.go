@@ -20,7 +20,7 @@ import (
 	"errors"
 	"fmt"
 
-	"github.com/liquidweb/liquidweb-cli/types/api"
+	lwClient "github.com/liquidweb/liquidweb-cli/client"
 	"github.com/liquidweb/liquidweb-cli/validate"
 )
 
@@ -62,34 +62,24 @@ func (self *Client) CloudServerResize(params *CloudServerResizeParams) (result s
 		return
 	}
 
-	// convert bool to int for api
-	skipFsResizeInt := 0
-	if params.SkipFsResize {
-		skipFsResizeInt = 1
-	}
-
 	if params.ConfigId == -1 && params.PrivateParent == "" {
 		err = errors.New("flag --config-id required when --private-parent is not given")
 		return
 	}
 
-	resizePlanArgs := map[string]interface{}{
-		"uniq_id": params.UniqId,
+	resizePlanArgs := &lwClient.StormServerResizePlanRequest{
+		UniqId: params.UniqId,
 	}
 
-	resizeArgs := map[string]interface{}{
-		"uniq_id":        params.UniqId,
-		"skip_fs_resize": skipFsResizeInt,
-		"newsize":        params.ConfigId,
+	resizeArgs := &lwClient.ServerResizeRequest{
+		UniqId:       params.UniqId,
+		SkipFsResize: lwClient.IntBool(params.SkipFsResize),
+		NewSize:      params.ConfigId,
 	}
 
 	// get details of existing configuration
-	var cloudServerDetails apiTypes.CloudServerDetails
-	if err = self.CallLwApiInto(
-		"bleed/storm/server/details",
-		map[string]interface{}{
-			"uniq_id": params.UniqId,
-		}, &cloudServerDetails); err != nil {
+	cloudServerDetails, err := self.Api.Storm.Server.Details(self.Context(), params.UniqId)
+	if err != nil {
 		return
 	}
 
@@ -108,7 +98,7 @@ func (self *Client) CloudServerResize(params *CloudServerResizeParams) (result s
 
 		validateFields[params.ConfigId] = "PositiveInt64"
 
-		resizePlanArgs["config_id"] = params.ConfigId
+		resizePlanArgs.ConfigId = params.ConfigId
 	} else {
 		// private parent resize specific logic
 		if params.Memory == -1 && params.DiskSpace == -1 && params.Vcpu == -1 {
@@ -122,46 +112,46 @@ func (self *Client) CloudServerResize(params *CloudServerResizeParams) (result s
 			return
 		}
 
-		resizeArgs["newsize"] = 0                  // 0 indicates private parent resize
-		resizeArgs["parent"] = privateParentUniqId // uniq_id of the private parent
+		resizeArgs.NewSize = 0                  // 0 indicates private parent resize
+		resizeArgs.Parent = privateParentUniqId // uniq_id of the private parent
 		validateFields[privateParentUniqId] = "UniqId"
 		// server/resize api method always wants diskspace, vcpu, memory passed for pp resize, even if not changing
 		// value. So set to current value, then override based on passed flags.
-		resizeArgs["diskspace"] = cloudServerDetails.DiskSpace
-		resizeArgs["memory"] = cloudServerDetails.Memory
-		resizeArgs["vcpu"] = cloudServerDetails.Vcpu
+		resizeArgs.Diskspace = cloudServerDetails.DiskSpace
+		resizeArgs.Memory = cloudServerDetails.Memory
+		resizeArgs.Vcpu = cloudServerDetails.Vcpu
 
 		if params.DiskSpace != -1 {
-			resizeArgs["diskspace"] = params.DiskSpace // desired diskspace
+			resizeArgs.Diskspace = params.DiskSpace // desired diskspace
 			validateFields[params.DiskSpace] = "PositiveInt64"
 		}
 		if params.Memory != -1 {
-			resizeArgs["memory"] = params.Memory // desired memory
+			resizeArgs.Memory = params.Memory // desired memory
 			validateFields[params.Memory] = "PositiveInt64"
 		}
 		if params.Vcpu != -1 {
-			resizeArgs["vcpu"] = params.Vcpu // desired vcpus
+			resizeArgs.Vcpu = params.Vcpu // desired vcpus
 			validateFields[params.Vcpu] = "PositiveInt64"
 		}
 
-		resizePlanArgs["config_id"] = 0
-		resizePlanArgs["private_parent"] = privateParentUniqId
-		resizePlanArgs["memory"] = resizeArgs["memory"]
-		resizePlanArgs["disk"] = resizeArgs["diskspace"]
-		resizePlanArgs["vcpu"] = resizeArgs["vcpu"]
+		resizePlanArgs.ConfigId = 0
+		resizePlanArgs.PrivateParent = privateParentUniqId
+		resizePlanArgs.Memory = resizeArgs.Memory
+		resizePlanArgs.Disk = resizeArgs.Diskspace
+		resizePlanArgs.Vcpu = resizeArgs.Vcpu
 	}
 
 	if err = validate.Validate(validateFields); err != nil {
 		return
 	}
 
-	var expectation apiTypes.CloudServerResizeExpectation
-	if err = self.CallLwApiInto("bleed/storm/server/resizePlan", resizePlanArgs, &expectation); err != nil {
+	expectation, err := self.Api.Storm.Server.ResizePlan(self.Context(), resizePlanArgs)
+	if err != nil {
 		err = fmt.Errorf("Configuration Not Available\n\n%s\n", err)
 		return
 	}
 
-	if _, err = self.LwCliApiClient.Call("bleed/server/resize", resizeArgs); err != nil {
+	if err = self.Api.Server.Resize(self.Context(), resizeArgs); err != nil {
 		return
 	}
 
@@ -18,7 +18,6 @@ package instance
 import (
 	"errors"
 
-	"github.com/liquidweb/liquidweb-cli/types/api"
 	"github.com/liquidweb/liquidweb-cli/validate"
 )
 
@@ -39,10 +38,7 @@ func (ci *Client) CloudTemplateRestore(params *CloudTemplateRestoreParams) (stri
 		return "", errors.New("template cannot be blank")
 	}
 
-	apiArgs := map[string]interface{}{"template": params.Template, "uniq_id": params.UniqId}
-
-	var details apiTypes.CloudTemplateRestoreResponse
-	err := ci.CallLwApiInto("bleed/storm/template/restore", apiArgs, &details)
+	details, err := ci.Api.Storm.Template.Restore(ci.Context(), params.UniqId, params.Template)
 	if err != nil {
 		return "", err
 	}
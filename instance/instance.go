@@ -17,14 +17,15 @@ package instance
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"os"
-	"reflect"
 
-	"github.com/mitchellh/mapstructure"
 	"github.com/spf13/viper"
 
+	lwClient "github.com/liquidweb/liquidweb-cli/client"
 	lwCliInstApi "github.com/liquidweb/liquidweb-cli/instance/api"
 
 	"github.com/liquidweb/liquidweb-cli/types/api"
@@ -42,6 +43,7 @@ func New(viper *viper.Viper) (*Client, error) {
 
 	client := &Client{
 		LwCliApiClient: lwCliApiClient,
+		Api:            lwClient.New(lwCliApiClient),
 		Viper:          viper,
 	}
 
@@ -49,9 +51,30 @@ func New(viper *viper.Viper) (*Client, error) {
 }
 
 func (*Client) Die(err error) {
+	if errors.Is(err, context.Canceled) {
+		utils.PrintYellow("Interrupted:\n\n")
+		fmt.Printf("%s\n\n", err)
+		os.Exit(errorTypes.ExitCode(err))
+	}
+	if errors.Is(err, context.DeadlineExceeded) {
+		utils.PrintRed("Timed out (see --timeout):\n\n")
+		fmt.Printf("%s\n\n", err)
+		os.Exit(errorTypes.ExitCode(err))
+	}
+
 	utils.PrintRed("A fatal error has occurred:\n\n")
 	fmt.Printf("%s\n\n", err)
-	os.Exit(1)
+	os.Exit(errorTypes.ExitCode(err))
+}
+
+// Context returns the context commands run under. It is cancelled on interrupt and
+// carries the --timeout deadline, if any.
+func (client *Client) Context() context.Context {
+	if client.LwCliApiClient == nil || client.LwCliApiClient.Context == nil {
+		return context.Background()
+	}
+
+	return client.LwCliApiClient.Context
 }
 
 func (*Client) JsonEncodeAndPrettyPrint(data interface{}) (string, error) {
@@ -77,98 +100,42 @@ func (*Client) JsonPrettyPrint(inJson string) (string, error) {
 }
 
 func (client *Client) CallLwApiInto(method string, methodArgs map[string]interface{}, obj interface{}) (err error) {
-	got, err := client.LwCliApiClient.Call(method, methodArgs)
-	if err != nil {
-		return
-	}
-
-	err = CastFieldTypes(got, &obj)
+	err = client.CallLwApiIntoContext(client.Context(), method, methodArgs, obj)
 
 	return
 }
 
-func (client *Client) AllPaginatedResults(args *AllPaginatedResultsArgs) (apiTypes.MergedPaginatedList, error) {
-
-	if args.Method == "" {
-		return apiTypes.MergedPaginatedList{}, fmt.Errorf("%w Method", errorTypes.LwCliInputError)
-	}
-
-	resultsPerPage := int64(500)
-	if args.ResultsPerPage != 0 {
-		resultsPerPage = args.ResultsPerPage
-	}
+func (client *Client) CallLwApiIntoContext(ctx context.Context, method string, methodArgs map[string]interface{},
+	obj interface{}) (err error) {
+	err = client.Api.Call(ctx, method, methodArgs, obj)
 
-	methodArgs := args.MethodArgs
-	if methodArgs == nil {
-		methodArgs = map[string]interface{}{
-			"page_size": resultsPerPage,
-		}
-	} else {
-		methodArgs["page_size"] = resultsPerPage
-	}
-
-	got, err := client.LwCliApiClient.Call(args.Method, methodArgs)
-	if err != nil {
-		return apiTypes.MergedPaginatedList{}, err
-	}
+	return
+}
 
-	var list apiTypes.PaginatedList
-	if err := CastFieldTypes(got, &list); err != nil {
-		return apiTypes.MergedPaginatedList{}, err
-	}
+func (client *Client) AllPaginatedResults(args *AllPaginatedResultsArgs) (apiTypes.MergedPaginatedList, error) {
+	return client.AllPaginatedResultsContext(client.Context(), args)
+}
 
-	mergedList := apiTypes.MergedPaginatedList{
-		Items:    list.Items,
-		PageSize: resultsPerPage,
-	}
+func (client *Client) AllPaginatedResultsContext(ctx context.Context,
+	args *AllPaginatedResultsArgs) (apiTypes.MergedPaginatedList, error) {
+	return client.Api.All(ctx, args.Method, args.MethodArgs, args.listOptions())
+}
 
-	nextPage := list.PageNum + 1
-	if list.PageNum < list.PageTotal {
-		morePages := true
-
-		for morePages {
-			methodArgs["page_num"] = nextPage
-			got, err := client.LwCliApiClient.Call(args.Method, methodArgs)
-			if err != nil {
-				return apiTypes.MergedPaginatedList{}, err
-			}
-
-			var page apiTypes.PaginatedList
-			if err := CastFieldTypes(got, &page); err != nil {
-				return apiTypes.MergedPaginatedList{}, err
-			}
-
-			// append page to mergedList
-			for _, item := range page.Items {
-				mergedList.Items = append(mergedList.Items, item)
-			}
-
-			nextPage++
-			if nextPage > page.PageTotal {
-				morePages = false
-			}
-		}
-	}
+// ForEachPaginatedItem calls fn for every item returned by a paginated API method,
+// fetching one page at a time. Unlike AllPaginatedResults, items are never merged
+// in memory, so callers can start printing as soon as the first page arrives.
+// Returning false from fn (or reaching args.Limit) stops fetching further pages.
+func (client *Client) ForEachPaginatedItem(args *AllPaginatedResultsArgs, fn PaginatedItemFunc) error {
+	return client.ForEachPaginatedItemContext(client.Context(), args, fn)
+}
 
-	mergedList.MergedPages = nextPage - 1
+func (client *Client) ForEachPaginatedItemContext(ctx context.Context, args *AllPaginatedResultsArgs,
+	fn PaginatedItemFunc) error {
+	_, _, err := client.Api.Paginate(ctx, args.Method, args.MethodArgs, args.listOptions(), lwClient.ItemFunc(fn))
 
-	return mergedList, nil
+	return err
 }
 
-func CastFieldTypes(source interface{}, dest interface{}) (err error) {
-	defer func() {
-		if paniced := recover(); paniced != nil {
-			err = fmt.Errorf("%w source [%+v] dest type [%s]: %+v",
-				errorTypes.LwApiUnexpectedResponseStructure, source,
-				reflect.TypeOf(dest).String(), paniced)
-		}
-	}()
-
-	if err = mapstructure.WeakDecode(source, &dest); err != nil {
-		err = fmt.Errorf("%w\nsource [%+v] dest type [%s] error: %+v",
-			errorTypes.LwApiUnexpectedResponseStructure, source,
-			reflect.TypeOf(dest).String(), err)
-	}
-
-	return
+func CastFieldTypes(source interface{}, dest interface{}) error {
+	return lwClient.Decode(source, dest)
 }
@@ -96,6 +96,12 @@ func (ci *Client) processPlanCloud(cloud *PlanCloud) error {
 }
 
 func (ci *Client) processPlanSsh(params *SshParams) (err error) {
+	// ssh doesn't go through the api client, so check for an interrupt ourselves.
+	if err = ci.Context().Err(); err != nil {
+		err = fmt.Errorf("plan ssh step to [%s] not started: %w", params.Host, err)
+		return
+	}
+
 	err = ci.Ssh(params)
 
 	return
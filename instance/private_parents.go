@@ -19,6 +19,7 @@ import (
 	"fmt"
 	"strings"
 
+	lwClient "github.com/liquidweb/liquidweb-cli/client"
 	"github.com/liquidweb/liquidweb-cli/types/api"
 	"github.com/liquidweb/liquidweb-cli/validate"
 )
@@ -35,10 +36,7 @@ func (ci *Client) DerivePrivateParentUniqId(name string) (uniqId string, zone in
 		strings.ToUpper(name): "UniqId",
 	}
 	if err := validate.Validate(validateFields); err == nil {
-		var privateParentDetails apiTypes.CloudPrivateParentDetails
-		if err := ci.CallLwApiInto("bleed/storm/private/parent/details",
-			map[string]interface{}{"uniq_id": name},
-			&privateParentDetails); err == nil {
+		if privateParentDetails, err := ci.Api.Storm.PrivateParent.Details(ci.Context(), name); err == nil {
 			uniqId = name
 			zone = privateParentDetails.Zone.Id
 		} else {
@@ -50,38 +48,27 @@ func (ci *Client) DerivePrivateParentUniqId(name string) (uniqId string, zone in
 
 	// if we havent found the pp details yet, try assuming name is the name of the pp
 	if uniqId == "" {
-		methodArgs := AllPaginatedResultsArgs{
-			Method:         "bleed/storm/private/parent/list",
-			ResultsPerPage: 100,
-		}
-		results, err := ci.AllPaginatedResults(&methodArgs)
-		if err == nil {
-			for _, item := range results.Items {
-				var privateParentDetails apiTypes.CloudPrivateParentDetails
-				if err := CastFieldTypes(item, &privateParentDetails); err != nil {
-					privateParentDetailsErr = fmt.Errorf("%s %w", privateParentDetailsErr, err)
-					break
-				}
+		listOpts := lwClient.ListOptions{PageSize: 100}
+		// stream the list so we stop paging as soon as the private parent is found
+		err := ci.Api.Storm.PrivateParent.Each(ci.Context(), listOpts, func(
+			listEntry *apiTypes.CloudPrivateParentDetails) (bool, error) {
+			if listEntry.Domain != name {
+				return true, nil
+			}
 
-				if privateParentDetails.Domain == name {
-					// found it get details
-					err := ci.CallLwApiInto("bleed/storm/private/parent/details",
-						map[string]interface{}{
-							"uniq_id": privateParentDetails.UniqId,
-						},
-						&privateParentDetails)
-					if err != nil {
-						privateParentDetailsErr = fmt.Errorf(
-							"failed fetching private parent details for discovered uniq-id [%s] error: %w %s",
-							privateParentDetails.UniqId, err, privateParentDetailsErr)
-						break
-					}
-					uniqId = privateParentDetails.UniqId
-					zone = privateParentDetails.Zone.Id
-					break // found the uniq_id so break
-				}
+			// found it get details
+			privateParentDetails, err := ci.Api.Storm.PrivateParent.Details(ci.Context(), listEntry.UniqId)
+			if err != nil {
+				return false, fmt.Errorf(
+					"failed fetching private parent details for discovered uniq-id [%s] error: %w",
+					listEntry.UniqId, err)
 			}
-		} else {
+			uniqId = privateParentDetails.UniqId
+			zone = privateParentDetails.Zone.Id
+
+			return false, nil // found the uniq_id so stop
+		})
+		if err != nil {
 			privateParentDetailsErr = fmt.Errorf("%s %w", privateParentDetailsErr, err)
 		}
 	}
@@ -20,6 +20,7 @@ import (
 	"os"
 	"os/exec"
 
+	lwClient "github.com/liquidweb/liquidweb-cli/client"
 	"github.com/liquidweb/liquidweb-cli/types/api"
 	"github.com/liquidweb/liquidweb-cli/validate"
 )
@@ -53,36 +54,26 @@ func (self *SshParams) TranslateHost(ci *Client) (ip string, err error) {
 	}
 
 	if err = validate.Validate(validateFields); err == nil {
-		var subaccnt apiTypes.Subaccnt
-		apiArgs := map[string]interface{}{
-			"uniq_id": self.Host,
-		}
-		if aErr := ci.CallLwApiInto("bleed/asset/details", apiArgs, &subaccnt); aErr != nil {
+		subaccnt, aErr := ci.Api.Asset.Details(ci.Context(), self.Host)
+		if aErr != nil {
 			err = aErr
 			return
 		}
 
 		ip = subaccnt.Ip
 	} else {
-		methodArgs := AllPaginatedResultsArgs{
-			Method:         "bleed/asset/list",
-			ResultsPerPage: 100,
+		listArgs := &lwClient.AssetListRequest{
+			ListOptions: lwClient.ListOptions{PageSize: 100},
 		}
-		results, aErr := ci.AllPaginatedResults(&methodArgs)
-		if aErr != nil {
-			err = aErr
-			return
-		}
-		for _, item := range results.Items {
-			var subaccnt apiTypes.Subaccnt
-			if err = CastFieldTypes(item, &subaccnt); err != nil {
-				return
-			}
-
+		err = ci.Api.Asset.Each(ci.Context(), listArgs, func(subaccnt *apiTypes.Subaccnt) (bool, error) {
 			if subaccnt.Domain == self.Host {
 				ip = subaccnt.Ip
-				break
+				return false, nil
 			}
+			return true, nil
+		})
+		if err != nil {
+			return
 		}
 	}
 
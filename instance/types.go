@@ -18,16 +18,32 @@ package instance
 import (
 	"github.com/spf13/viper"
 
+	lwClient "github.com/liquidweb/liquidweb-cli/client"
 	lwCliInstApi "github.com/liquidweb/liquidweb-cli/instance/api"
 )
 
 type Client struct {
 	LwCliApiClient *lwCliInstApi.LwCliApiClient
-	Viper          *viper.Viper
+	// Api is the typed client built on LwCliApiClient.
+	Api   *lwClient.Client
+	Viper *viper.Viper
 }
 
 type AllPaginatedResultsArgs struct {
 	Method         string
 	MethodArgs     map[string]interface{}
 	ResultsPerPage int64
+	// Limit stops pagination once this many items have been seen. Zero means no limit.
+	Limit int64
+}
+
+// PaginatedItemFunc is called once per item by ForEachPaginatedItem. Returning false
+// stops iteration without fetching any further pages.
+type PaginatedItemFunc func(item map[string]interface{}) (more bool, err error)
+
+func (args *AllPaginatedResultsArgs) listOptions() lwClient.ListOptions {
+	return lwClient.ListOptions{
+		PageSize: args.ResultsPerPage,
+		Limit:    args.Limit,
+	}
 }
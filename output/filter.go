@@ -0,0 +1,383 @@
+/*
+Copyright © LiquidWeb
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package output
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/liquidweb/liquidweb-cli/types/errors"
+)
+
+// Filter is a parsed --filter expression: comma separated conditions, all of which an
+// item must meet. A condition compares a field, given by its json name and nested with
+// dots, to a value:
+//
+//	domain=web1.example.com     equal
+//	status!=Active              not equal
+//	domain=~^web                matches the regular expression
+//	domain!~staging             doesn't match the regular expression
+//	vcpu>=4                     compares numerically when both sides are numbers
+//	zone.region.name=US Central
+//
+// When a field is a list, the condition holds if any element meets it. Commas in values
+// can be escaped with a backslash.
+type Filter struct {
+	conditions []filterCondition
+}
+
+type filterCondition struct {
+	field    string
+	path     *Path
+	operator string
+	value    string
+	pattern  *regexp.Regexp
+}
+
+// two character operators come first, so "!=" isn't taken for "=".
+var conditionOperators = []string{"=~", "!~", "!=", ">=", "<=", "=", ">", "<"}
+
+// ParseFilter parses a --filter expression.
+func ParseFilter(expression string) (*Filter, error) {
+	filter := &Filter{}
+
+	for _, text := range splitUnescaped(expression, ',') {
+		if strings.TrimSpace(text) == "" {
+			continue
+		}
+
+		condition, err := parseCondition(text)
+		if err != nil {
+			return nil, err
+		}
+		filter.conditions = append(filter.conditions, condition)
+	}
+
+	return filter, nil
+}
+
+func parseCondition(text string) (condition filterCondition, err error) {
+	invalid := func(reason string) error {
+		return fmt.Errorf("%w: invalid filter condition [%s]: %s", errorTypes.LwCliInvalidFlagValue, text, reason)
+	}
+
+	at := -1
+	for _, operator := range conditionOperators {
+		if i := strings.Index(text, operator); i != -1 && (at == -1 || i < at) {
+			at = i
+			condition.operator = operator
+		}
+	}
+	if at == -1 {
+		err = invalid("expected field=value, or one of the operators " + strings.Join(conditionOperators, " "))
+		return
+	}
+
+	condition.field = strings.TrimSpace(text[:at])
+	condition.value = strings.TrimSpace(text[at+len(condition.operator):])
+	if condition.field == "" {
+		err = invalid("missing field name")
+		return
+	}
+	if condition.path, err = CompilePath(condition.field); err != nil {
+		return
+	}
+	if condition.operator == "=~" || condition.operator == "!~" {
+		if condition.pattern, err = regexp.Compile(condition.value); err != nil {
+			err = invalid(err.Error())
+		}
+	}
+
+	return
+}
+
+func splitUnescaped(text string, separator rune) (parts []string) {
+	var current strings.Builder
+	escaped := false
+	for _, c := range text {
+		switch {
+		case escaped:
+			if c != separator {
+				current.WriteRune('\\')
+			}
+			current.WriteRune(c)
+			escaped = false
+		case c == '\\':
+			escaped = true
+		case c == separator:
+			parts = append(parts, current.String())
+			current.Reset()
+		default:
+			current.WriteRune(c)
+		}
+	}
+	if escaped {
+		current.WriteRune('\\')
+	}
+
+	return append(parts, current.String())
+}
+
+// Matches reports whether item meets every condition of the filter.
+func (filter *Filter) Matches(item interface{}) (bool, error) {
+	if filter == nil || len(filter.conditions) == 0 {
+		return true, nil
+	}
+
+	generic, err := toGeneric(item)
+	if err != nil {
+		return false, err
+	}
+
+	for _, condition := range filter.conditions {
+		if !condition.matches(generic) {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+func (condition filterCondition) matches(generic interface{}) bool {
+	var values []interface{}
+	for _, found := range condition.path.Find(generic) {
+		if list, isList := found.([]interface{}); isList {
+			values = append(values, list...)
+		} else {
+			values = append(values, found)
+		}
+	}
+
+	// negated conditions hold when no value is equal or matching
+	negated := condition.operator == "!=" || condition.operator == "!~"
+	for _, value := range values {
+		if condition.holds(value) {
+			return !negated
+		}
+	}
+
+	return negated
+}
+
+// holds reports whether value meets the condition; for negated operators, whether it
+// meets the positive form.
+func (condition filterCondition) holds(value interface{}) bool {
+	switch condition.operator {
+	case "=~", "!~":
+		return condition.pattern.MatchString(FormatValue(value))
+	case "=", "!=":
+		return compareValues(value, condition.value) == 0
+	case ">":
+		return compareValues(value, condition.value) > 0
+	case ">=":
+		return compareValues(value, condition.value) >= 0
+	case "<":
+		return compareValues(value, condition.value) < 0
+	case "<=":
+		return compareValues(value, condition.value) <= 0
+	}
+
+	return false
+}
+
+// compareValues compares a decoded json value with text, numerically when both are
+// numbers.
+func compareValues(value interface{}, text string) int {
+	if left, ok := toFloat(value); ok {
+		if right, err := strconv.ParseFloat(text, 64); err == nil {
+			switch {
+			case left < right:
+				return -1
+			case left > right:
+				return 1
+			}
+			return 0
+		}
+	}
+
+	return strings.Compare(FormatValue(value), text)
+}
+
+// SortBy is a parsed --sort-by: a field given by its json name, nested with dots, and
+// prefixed with "-" to sort in descending order.
+type SortBy struct {
+	field      string
+	path       *Path
+	descending bool
+}
+
+// ParseSortBy parses a --sort-by field.
+func ParseSortBy(field string) (*SortBy, error) {
+	sortBy := &SortBy{field: strings.TrimSpace(field)}
+	if strings.HasPrefix(sortBy.field, "-") {
+		sortBy.descending = true
+		sortBy.field = strings.TrimPrefix(sortBy.field, "-")
+	}
+
+	var err error
+	if sortBy.path, err = CompilePath(sortBy.field); err != nil {
+		return nil, err
+	}
+
+	return sortBy, nil
+}
+
+// Sort sorts items, stably, by the field. Items missing it go last.
+func (sortBy *SortBy) Sort(items []interface{}) error {
+	keys := make([]interface{}, len(items))
+	for i, item := range items {
+		generic, err := toGeneric(item)
+		if err != nil {
+			return err
+		}
+		if found := sortBy.path.Find(generic); len(found) > 0 {
+			keys[i] = found[0]
+		}
+	}
+
+	indexes := make([]int, len(items))
+	for i := range indexes {
+		indexes[i] = i
+	}
+	sort.SliceStable(indexes, func(a, b int) bool {
+		left, right := keys[indexes[a]], keys[indexes[b]]
+		if left == nil || right == nil {
+			return left != nil
+		}
+		if sortBy.descending {
+			left, right = right, left
+		}
+		return sortKeyLess(left, right)
+	})
+
+	sorted := make([]interface{}, len(items))
+	for i, index := range indexes {
+		sorted[i] = items[index]
+	}
+	copy(items, sorted)
+
+	return nil
+}
+
+func sortKeyLess(left, right interface{}) bool {
+	leftNumber, leftIsNumber := toFloat(left)
+	rightNumber, rightIsNumber := toFloat(right)
+	if leftIsNumber && rightIsNumber {
+		return leftNumber < rightNumber
+	}
+
+	return FormatValue(left) < FormatValue(right)
+}
+
+// ListQuery filters, sorts and limits the items of a list command client side.
+type ListQuery struct {
+	Filter *Filter
+	SortBy *SortBy
+	// Limit is the most items passed on, zero for no limit.
+	Limit int64
+}
+
+// ItemFunc is called with each item of a list. Returning false stops the list.
+type ItemFunc func(item interface{}) (more bool, err error)
+
+// Active reports whether items have to be filtered or sorted, in which case the API
+// can't be asked to stop listing at Limit.
+func (query *ListQuery) Active() bool {
+	return (query.Filter != nil && len(query.Filter.conditions) > 0) || query.SortBy != nil
+}
+
+// ListLimit returns the limit the API can be asked to stop listing at; zero when items
+// have to be filtered or sorted first.
+func (query *ListQuery) ListLimit() int64 {
+	if query.Active() {
+		return 0
+	}
+
+	return query.Limit
+}
+
+// Run calls list with a function taking every item listed, and calls fn with the items
+// matching the filter, in order, up to Limit. Without --sort-by items are passed on as
+// they are listed; with it they are collected and passed on once list returns.
+func (query *ListQuery) Run(list func(visit ItemFunc) error, fn ItemFunc) error {
+	var passed int64
+	var collected []interface{}
+
+	pass := func(item interface{}) (bool, error) {
+		if query.Limit > 0 && passed >= query.Limit {
+			return false, nil
+		}
+		passed++
+		more, err := fn(item)
+		if query.Limit > 0 && passed >= query.Limit {
+			more = false
+		}
+		return more, err
+	}
+
+	err := list(func(item interface{}) (bool, error) {
+		matches, err := query.Filter.Matches(item)
+		if err != nil || !matches {
+			return err == nil, err
+		}
+		if query.SortBy != nil {
+			collected = append(collected, item)
+			return true, nil
+		}
+		return pass(item)
+	})
+	if err != nil || query.SortBy == nil {
+		return err
+	}
+
+	if err := query.SortBy.Sort(collected); err != nil {
+		return err
+	}
+	for _, item := range collected {
+		more, err := pass(item)
+		if err != nil {
+			return err
+		}
+		if !more {
+			break
+		}
+	}
+
+	return nil
+}
+
+// Items filters, sorts and limits items already listed.
+func (query *ListQuery) Items(items []map[string]interface{}) ([]map[string]interface{}, error) {
+	result := []map[string]interface{}{}
+
+	err := query.Run(func(visit ItemFunc) error {
+		for _, item := range items {
+			more, err := visit(item)
+			if err != nil || !more {
+				return err
+			}
+		}
+		return nil
+	}, func(item interface{}) (bool, error) {
+		result = append(result, item.(map[string]interface{}))
+		return true, nil
+	})
+
+	return result, err
+}
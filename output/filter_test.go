@@ -0,0 +1,208 @@
+/*
+Copyright © LiquidWeb
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package output
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/liquidweb/liquidweb-cli/types/errors"
+)
+
+func filterItems() []map[string]interface{} {
+	return []map[string]interface{}{
+		{"domain": "web1.example.com", "status": "Active", "vcpu": 4, "ips": []string{"10.0.0.1", "10.0.0.2"},
+			"zone": map[string]interface{}{"region": map[string]interface{}{"name": "US Central"}}, "note": "a,b"},
+		{"domain": "web2.staging.com", "status": "Stopped", "vcpu": 2, "ips": []string{"10.0.1.1"},
+			"zone": map[string]interface{}{"region": map[string]interface{}{"name": "US West"}}, "note": "c"},
+		{"domain": "db1.example.com", "status": "Active", "vcpu": 16, "ips": []string{},
+			"zone": map[string]interface{}{"region": map[string]interface{}{"name": "US Central"}}},
+	}
+}
+
+func domains(items []map[string]interface{}) []string {
+	domains := []string{}
+	for _, item := range items {
+		domains = append(domains, item["domain"].(string))
+	}
+
+	return domains
+}
+
+func TestFilter(t *testing.T) {
+	tests := []struct {
+		expression string
+		want       []string
+	}{
+		{"", []string{"web1.example.com", "web2.staging.com", "db1.example.com"}},
+		{"status=Active", []string{"web1.example.com", "db1.example.com"}},
+		{"status!=Active", []string{"web2.staging.com"}},
+		{" status = Active , ", []string{"web1.example.com", "db1.example.com"}},
+		{"domain=~^web", []string{"web1.example.com", "web2.staging.com"}},
+		{"domain!~staging", []string{"web1.example.com", "db1.example.com"}},
+		{`domain=~^web\d\.example`, []string{"web1.example.com"}},
+		// numbers compare numerically, not as text
+		{"vcpu>=4", []string{"web1.example.com", "db1.example.com"}},
+		{"vcpu>4", []string{"db1.example.com"}},
+		{"vcpu<4", []string{"web2.staging.com"}},
+		{"vcpu<=4", []string{"web1.example.com", "web2.staging.com"}},
+		{"vcpu=16", []string{"db1.example.com"}},
+		{"vcpu>2,status=Active", []string{"web1.example.com", "db1.example.com"}},
+		{"vcpu>2,status!=Active", []string{}},
+		// nested fields and lists
+		{"zone.region.name=US Central", []string{"web1.example.com", "db1.example.com"}},
+		{"zone.region.name=~West$", []string{"web2.staging.com"}},
+		{"ips=10.0.0.2", []string{"web1.example.com"}},
+		{"ips!=10.0.0.2", []string{"web2.staging.com", "db1.example.com"}},
+		{"ips=~^10\\.0\\.1\\.", []string{"web2.staging.com"}},
+		// escaped commas, and operators inside values
+		{`note=a\,b`, []string{"web1.example.com"}},
+		{`note=a\,b,status=Active`, []string{"web1.example.com"}},
+		{"note=c=d", []string{}},
+		// missing fields
+		{"missing=x", []string{}},
+		{"missing!=x", []string{"web1.example.com", "web2.staging.com", "db1.example.com"}},
+		{"note!=c", []string{"web1.example.com", "db1.example.com"}},
+	}
+
+	for _, test := range tests {
+		filter, err := ParseFilter(test.expression)
+		if err != nil {
+			t.Errorf("ParseFilter(%q): %s", test.expression, err)
+			continue
+		}
+		query := &ListQuery{Filter: filter}
+		items, err := query.Items(filterItems())
+		if err != nil {
+			t.Errorf("filtering with %q: %s", test.expression, err)
+			continue
+		}
+		if got := domains(items); !reflect.DeepEqual(got, test.want) {
+			t.Errorf("%q kept %v, want %v", test.expression, got, test.want)
+		}
+	}
+}
+
+func TestParseFilterInvalid(t *testing.T) {
+	for _, expression := range []string{
+		"status",
+		"=Active",
+		" =~web",
+		"domain=~[",
+		"domain!~(",
+		"status=Active,vcpu",
+		"a b=1",
+		"items[=1",
+	} {
+		_, err := ParseFilter(expression)
+		if err == nil {
+			t.Errorf("ParseFilter(%q) succeeded, want an error", expression)
+			continue
+		}
+		if !errors.Is(err, errorTypes.LwCliInvalidFlagValue) {
+			t.Errorf("ParseFilter(%q) error %q isn't an invalid flag value", expression, err)
+		}
+	}
+}
+
+func TestSplitUnescaped(t *testing.T) {
+	tests := []struct {
+		text string
+		want []string
+	}{
+		{"", []string{""}},
+		{"a,b", []string{"a", "b"}},
+		{`a\,b,c`, []string{"a,b", "c"}},
+		{`a\\b`, []string{`a\\b`}},
+		{`a\.b`, []string{`a\.b`}},
+		{`a\`, []string{`a\`}},
+		{"a,,b,", []string{"a", "", "b", ""}},
+	}
+
+	for _, test := range tests {
+		if got := splitUnescaped(test.text, ','); !reflect.DeepEqual(got, test.want) {
+			t.Errorf("splitUnescaped(%q) = %q, want %q", test.text, got, test.want)
+		}
+	}
+}
+
+func TestSortBy(t *testing.T) {
+	tests := []struct {
+		field string
+		limit int64
+		want  []string
+	}{
+		{"vcpu", 0, []string{"web2.staging.com", "web1.example.com", "db1.example.com"}},
+		{"-vcpu", 0, []string{"db1.example.com", "web1.example.com", "web2.staging.com"}},
+		{"domain", 0, []string{"db1.example.com", "web1.example.com", "web2.staging.com"}},
+		{"zone.region.name", 0, []string{"web1.example.com", "db1.example.com", "web2.staging.com"}},
+		// stable: equal keys keep their listed order, whichever the direction
+		{"status", 0, []string{"web1.example.com", "db1.example.com", "web2.staging.com"}},
+		{"-status", 0, []string{"web2.staging.com", "web1.example.com", "db1.example.com"}},
+		// items missing the field go last, whichever the direction
+		{"note", 0, []string{"web1.example.com", "web2.staging.com", "db1.example.com"}},
+		{"-note", 0, []string{"web2.staging.com", "web1.example.com", "db1.example.com"}},
+		// the limit applies after sorting
+		{"-vcpu", 1, []string{"db1.example.com"}},
+		{"vcpu", 2, []string{"web2.staging.com", "web1.example.com"}},
+	}
+
+	for _, test := range tests {
+		sortBy, err := ParseSortBy(test.field)
+		if err != nil {
+			t.Errorf("ParseSortBy(%q): %s", test.field, err)
+			continue
+		}
+		query := &ListQuery{SortBy: sortBy, Limit: test.limit}
+		items, err := query.Items(filterItems())
+		if err != nil {
+			t.Errorf("sorting by %q: %s", test.field, err)
+			continue
+		}
+		if got := domains(items); !reflect.DeepEqual(got, test.want) {
+			t.Errorf("sorting by %q, limit %d gave %v, want %v", test.field, test.limit, got, test.want)
+		}
+	}
+
+	if _, err := ParseSortBy("a b"); err == nil || !strings.Contains(err.Error(), "invalid path") {
+		t.Errorf("ParseSortBy(%q) = %v, want an invalid path error", "a b", err)
+	}
+}
+
+func TestListQueryLimit(t *testing.T) {
+	filter, err := ParseFilter("status=Active")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	query := &ListQuery{Filter: filter, Limit: 1}
+	if query.ListLimit() != 0 {
+		t.Errorf("ListLimit() = %d with a filter, want 0", query.ListLimit())
+	}
+	items, err := query.Items(filterItems())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := domains(items); !reflect.DeepEqual(got, []string{"web1.example.com"}) {
+		t.Errorf("limit 1 kept %v", got)
+	}
+
+	if limit := (&ListQuery{Limit: 5}).ListLimit(); limit != 5 {
+		t.Errorf("ListLimit() = %d without a filter or sort, want 5", limit)
+	}
+}
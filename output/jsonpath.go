@@ -0,0 +1,461 @@
+/*
+Copyright © LiquidWeb
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package output
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/liquidweb/liquidweb-cli/types/errors"
+)
+
+// Path is a compiled JSONPath expression. It supports the subset needed to pick values
+// out of command results, and accepts the jq spellings of the same:
+//
+//	$ or .          the whole result (the leading $ is optional)
+//	.name ['name']  a field
+//	[n] [-n]        an element of a list, counting from the end when negative
+//	[start:end]     a slice of a list
+//	[*] [] .*       every element of a list, or every value of an object
+//	..name ..*      recursive descent
+//	[?(@.a == 1)]   elements matching a filter; ==, !=, <, <=, >, >= or just @.a to test presence
+//
+// Surrounding braces, as in kubectl's {.items[*].ip}, are ignored.
+type Path struct {
+	expression string
+	steps      []pathStep
+}
+
+type pathStepKind int
+
+const (
+	stepField pathStepKind = iota
+	stepIndex
+	stepSlice
+	stepWildcard
+	stepRecursive
+	stepFilter
+)
+
+type pathStep struct {
+	kind pathStepKind
+	// field is the name of stepField, and of stepRecursive when not a wildcard.
+	field      string
+	index      int
+	start, end *int
+	filter     *pathFilter
+}
+
+type pathFilter struct {
+	path     *Path
+	operator string
+	// value is the literal compared against, decoded as json.
+	value interface{}
+}
+
+// CompilePath parses expression.
+func CompilePath(expression string) (*Path, error) {
+	path := &Path{expression: expression}
+
+	text := strings.TrimSpace(expression)
+	if strings.HasPrefix(text, "{") && strings.HasSuffix(text, "}") {
+		text = strings.TrimSpace(text[1 : len(text)-1])
+	}
+	text = strings.TrimPrefix(text, "$")
+	if text == "." {
+		text = ""
+	}
+
+	var err error
+	if path.steps, err = parsePathSteps(text); err != nil {
+		return nil, fmt.Errorf("%w: invalid path [%s]: %s", errorTypes.LwCliInvalidFlagValue, expression, err)
+	}
+
+	return path, nil
+}
+
+func (path *Path) String() string {
+	return path.expression
+}
+
+func parsePathSteps(text string) (steps []pathStep, err error) {
+	for pos := 0; pos < len(text); {
+		switch {
+		case strings.HasPrefix(text[pos:], ".."):
+			pos += 2
+			name, next := scanName(text, pos)
+			if name == "" && next < len(text) && text[next] == '*' {
+				next++
+			}
+			if name == "" && next == pos {
+				return nil, fmt.Errorf("expected a field name after '..' at offset %d", pos)
+			}
+			steps = append(steps, pathStep{kind: stepRecursive, field: name})
+			pos = next
+		case text[pos] == '.':
+			pos++
+			if pos < len(text) && text[pos] == '*' {
+				steps = append(steps, pathStep{kind: stepWildcard})
+				pos++
+				continue
+			}
+			name, next := scanName(text, pos)
+			if name == "" {
+				// a lone "." before a bracket, as in jq's .[0]
+				if pos < len(text) && text[pos] == '[' {
+					continue
+				}
+				return nil, fmt.Errorf("expected a field name at offset %d", pos)
+			}
+			steps = append(steps, pathStep{kind: stepField, field: name})
+			pos = next
+		case text[pos] == '[':
+			end := matchingBracket(text, pos)
+			if end == -1 {
+				return nil, fmt.Errorf("unterminated '[' at offset %d", pos)
+			}
+			step, err := parseBracket(strings.TrimSpace(text[pos+1 : end]))
+			if err != nil {
+				return nil, err
+			}
+			steps = append(steps, step)
+			pos = end + 1
+		default:
+			// a path may start with a bare field name, as in items[0]
+			name, next := scanName(text, pos)
+			if name == "" || pos != 0 {
+				return nil, fmt.Errorf("unexpected character %q at offset %d", text[pos], pos)
+			}
+			steps = append(steps, pathStep{kind: stepField, field: name})
+			pos = next
+		}
+	}
+
+	return
+}
+
+func scanName(text string, pos int) (string, int) {
+	start := pos
+	for pos < len(text) {
+		c := text[pos]
+		if c == '_' || c == '-' || c >= '0' && c <= '9' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' {
+			pos++
+			continue
+		}
+		break
+	}
+
+	return text[start:pos], pos
+}
+
+// matchingBracket returns the offset of the ']' closing the '[' at pos, skipping quoted
+// strings and nested brackets.
+func matchingBracket(text string, pos int) int {
+	depth := 0
+	var quote byte
+	for i := pos; i < len(text); i++ {
+		c := text[i]
+		switch {
+		case quote != 0:
+			if c == '\\' {
+				i++
+			} else if c == quote {
+				quote = 0
+			}
+		case c == '\'' || c == '"':
+			quote = c
+		case c == '[':
+			depth++
+		case c == ']':
+			depth--
+			if depth == 0 {
+				return i
+			}
+		}
+	}
+
+	return -1
+}
+
+func parseBracket(inner string) (pathStep, error) {
+	switch {
+	case inner == "" || inner == "*":
+		return pathStep{kind: stepWildcard}, nil
+	case strings.HasPrefix(inner, "?"):
+		filter, err := parseFilter(inner)
+		return pathStep{kind: stepFilter, filter: filter}, err
+	case inner[0] == '\'' || inner[0] == '"':
+		name, err := unquote(inner)
+		return pathStep{kind: stepField, field: name}, err
+	case strings.Contains(inner, ":"):
+		parts := strings.SplitN(inner, ":", 2)
+		step := pathStep{kind: stepSlice}
+		for i, part := range parts {
+			part = strings.TrimSpace(part)
+			if part == "" {
+				continue
+			}
+			n, err := strconv.Atoi(part)
+			if err != nil {
+				return step, fmt.Errorf("invalid slice bound [%s]", part)
+			}
+			if i == 0 {
+				step.start = &n
+			} else {
+				step.end = &n
+			}
+		}
+		return step, nil
+	}
+
+	n, err := strconv.Atoi(inner)
+	if err != nil {
+		return pathStep{}, fmt.Errorf("invalid index [%s]", inner)
+	}
+
+	return pathStep{kind: stepIndex, index: n}, nil
+}
+
+func unquote(quoted string) (string, error) {
+	if len(quoted) < 2 || quoted[len(quoted)-1] != quoted[0] {
+		return "", fmt.Errorf("unterminated string [%s]", quoted)
+	}
+	if quoted[0] == '\'' {
+		// requote as a go string, in which ' needn't be escaped and " must be
+		var requoted strings.Builder
+		requoted.WriteByte('"')
+		inner := quoted[1 : len(quoted)-1]
+		for i := 0; i < len(inner); i++ {
+			switch {
+			case inner[i] == '\\' && i+1 < len(inner):
+				if inner[i+1] != '\'' {
+					requoted.WriteByte('\\')
+				}
+				requoted.WriteByte(inner[i+1])
+				i++
+			case inner[i] == '"':
+				requoted.WriteString(`\"`)
+			default:
+				requoted.WriteByte(inner[i])
+			}
+		}
+		requoted.WriteByte('"')
+		quoted = requoted.String()
+	}
+
+	return strconv.Unquote(quoted)
+}
+
+var filterOperators = []string{"==", "!=", "<=", ">=", "<", ">"}
+
+func parseFilter(inner string) (*pathFilter, error) {
+	expression := strings.TrimSpace(strings.TrimPrefix(inner, "?"))
+	if !strings.HasPrefix(expression, "(") || !strings.HasSuffix(expression, ")") {
+		return nil, fmt.Errorf("filter [%s] must be of the form ?(@.field == value)", inner)
+	}
+	expression = strings.TrimSpace(expression[1 : len(expression)-1])
+
+	filter := &pathFilter{}
+	left := expression
+	for _, operator := range filterOperators {
+		if i := strings.Index(expression, operator); i != -1 {
+			filter.operator = operator
+			left = strings.TrimSpace(expression[:i])
+			right := strings.TrimSpace(expression[i+len(operator):])
+			if len(right) > 0 && right[0] == '\'' {
+				unquoted, err := unquote(right)
+				if err != nil {
+					return nil, err
+				}
+				filter.value = unquoted
+			} else if err := json.Unmarshal([]byte(right), &filter.value); err != nil {
+				return nil, fmt.Errorf("invalid value [%s] in filter", right)
+			}
+			break
+		}
+	}
+
+	if !strings.HasPrefix(left, "@") {
+		return nil, fmt.Errorf("filter [%s] must test a path starting with @", inner)
+	}
+	var err error
+	filter.path, err = CompilePath(strings.TrimPrefix(left, "@"))
+
+	return filter, err
+}
+
+// Find returns every value the path matches in data, which should be decoded json (see
+// toGeneric).
+func (path *Path) Find(data interface{}) []interface{} {
+	current := []interface{}{data}
+	for _, step := range path.steps {
+		var next []interface{}
+		for _, value := range current {
+			next = append(next, step.apply(value)...)
+		}
+		current = next
+	}
+
+	return current
+}
+
+func (step pathStep) apply(value interface{}) (matches []interface{}) {
+	switch step.kind {
+	case stepField:
+		if object, ok := value.(map[string]interface{}); ok {
+			if field, exists := object[step.field]; exists {
+				matches = append(matches, field)
+			}
+		}
+	case stepIndex:
+		if list, ok := value.([]interface{}); ok {
+			index := step.index
+			if index < 0 {
+				index += len(list)
+			}
+			if index >= 0 && index < len(list) {
+				matches = append(matches, list[index])
+			}
+		}
+	case stepSlice:
+		if list, ok := value.([]interface{}); ok {
+			start, end := 0, len(list)
+			if step.start != nil {
+				start = clampIndex(*step.start, len(list))
+			}
+			if step.end != nil {
+				end = clampIndex(*step.end, len(list))
+			}
+			if start < end {
+				matches = append(matches, list[start:end]...)
+			}
+		}
+	case stepWildcard:
+		matches = children(value)
+	case stepRecursive:
+		matches = descend(value, step.field)
+	case stepFilter:
+		for _, child := range children(value) {
+			if step.filter.matches(child) {
+				matches = append(matches, child)
+			}
+		}
+	}
+
+	return
+}
+
+func clampIndex(index, length int) int {
+	if index < 0 {
+		index += length
+	}
+	if index < 0 {
+		return 0
+	}
+	if index > length {
+		return length
+	}
+
+	return index
+}
+
+// children returns the elements of a list, or the values of an object ordered by key.
+func children(value interface{}) []interface{} {
+	switch typed := value.(type) {
+	case []interface{}:
+		return typed
+	case map[string]interface{}:
+		var values []interface{}
+		for _, key := range sortedKeys(typed) {
+			values = append(values, typed[key])
+		}
+		return values
+	}
+
+	return nil
+}
+
+// descend returns field of value and of everything below it; every value below it when
+// field is empty.
+func descend(value interface{}, field string) (matches []interface{}) {
+	if object, ok := value.(map[string]interface{}); ok && field != "" {
+		if match, exists := object[field]; exists {
+			matches = append(matches, match)
+		}
+	}
+
+	for _, child := range children(value) {
+		if field == "" {
+			matches = append(matches, child)
+		}
+		matches = append(matches, descend(child, field)...)
+	}
+
+	return
+}
+
+func (filter *pathFilter) matches(value interface{}) bool {
+	found := filter.path.Find(value)
+	if len(found) == 0 {
+		return false
+	}
+	if filter.operator == "" {
+		return true
+	}
+
+	left := found[0]
+	if leftNumber, ok := toFloat(left); ok {
+		if rightNumber, ok := toFloat(filter.value); ok {
+			return compare(filter.operator, leftNumber-rightNumber)
+		}
+	}
+
+	leftString, rightString := FormatValue(left), FormatValue(filter.value)
+	return compare(filter.operator, float64(strings.Compare(leftString, rightString)))
+}
+
+func compare(operator string, difference float64) bool {
+	switch operator {
+	case "==":
+		return difference == 0
+	case "!=":
+		return difference != 0
+	case "<":
+		return difference < 0
+	case "<=":
+		return difference <= 0
+	case ">":
+		return difference > 0
+	case ">=":
+		return difference >= 0
+	}
+
+	return false
+}
+
+func toFloat(value interface{}) (float64, bool) {
+	switch typed := value.(type) {
+	case json.Number:
+		f, err := typed.Float64()
+		return f, err == nil
+	case float64:
+		return typed, true
+	}
+
+	return 0, false
+}
@@ -0,0 +1,157 @@
+/*
+Copyright © LiquidWeb
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package output
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/liquidweb/liquidweb-cli/types/errors"
+)
+
+const pathDocument = `{
+	"items": [
+		{"id": 1, "ip": "10.0.0.1", "zone": {"name": "a"}, "tags": ["x", "y"]},
+		{"id": 2, "ip": "10.0.0.2", "zone": {"name": "b"}},
+		{"id": 3, "ip": "10.0.0.3", "zone": {"name": "a"}}
+	],
+	"total": 3,
+	"odd key": "v",
+	"it's": "q"
+}`
+
+// decodeGeneric decodes document the way toGeneric does.
+func decodeGeneric(t *testing.T, document string) (generic interface{}) {
+	t.Helper()
+
+	decoder := json.NewDecoder(bytes.NewReader([]byte(document)))
+	decoder.UseNumber()
+	if err := decoder.Decode(&generic); err != nil {
+		t.Fatal(err)
+	}
+
+	return
+}
+
+func TestPathFind(t *testing.T) {
+	data := decodeGeneric(t, pathDocument)
+
+	tests := []struct {
+		expression string
+		want       string
+	}{
+		{".total", `[3]`},
+		{"$.total", `[3]`},
+		{"total", `[3]`},
+		{"{.total}", `[3]`},
+		{".items[*].ip", `["10.0.0.1","10.0.0.2","10.0.0.3"]`},
+		{".items[].ip", `["10.0.0.1","10.0.0.2","10.0.0.3"]`},
+		{".items.*.id", `[1,2,3]`},
+		{"{.items[*].id}", `[1,2,3]`},
+		// indexes and slices
+		{".items[0].id", `[1]`},
+		{".items[-1].id", `[3]`},
+		{".items[5].id", `null`},
+		{".items[1:].id", `[2,3]`},
+		{".items[:-1].id", `[1,2]`},
+		{".items[-2:10].id", `[2,3]`},
+		{".items[2:1].id", `null`},
+		{"items[0].ip", `["10.0.0.1"]`},
+		// nested and quoted fields
+		{".items[0].zone.name", `["a"]`},
+		{".items[*].zone.name", `["a","b","a"]`},
+		{".items[0].tags[1]", `["y"]`},
+		{`.["items"][0].ip`, `["10.0.0.1"]`},
+		{"['odd key']", `["v"]`},
+		{`["odd key"]`, `["v"]`},
+		{`['it\'s']`, `["q"]`},
+		{`['odd\x20key']`, `["v"]`},
+		{`['say "hi"']`, `null`},
+		{".missing", `null`},
+		{".items[0].missing.deeper", `null`},
+		// recursive descent
+		{"..name", `["a","b","a"]`},
+		{".items[0]..*", `[1,"10.0.0.1",["x","y"],"x","y",{"name":"a"},"a"]`},
+		// filters
+		{".items[?(@.zone.name == 'a')].id", `[1,3]`},
+		{`.items[?(@.ip != "10.0.0.2")].id`, `[1,3]`},
+		{".items[?(@.id > 1)].id", `[2,3]`},
+		{".items[?(@.id <= 2)].id", `[1,2]`},
+		{".items[?(@.tags)].id", `[1]`},
+		{".items[?(@.ip == 'a]b')].id", `null`},
+	}
+
+	for _, test := range tests {
+		path, err := CompilePath(test.expression)
+		if err != nil {
+			t.Errorf("CompilePath(%q): %s", test.expression, err)
+			continue
+		}
+		got, err := json.Marshal(path.Find(data))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(got) != test.want {
+			t.Errorf("%q found %s, want %s", test.expression, got, test.want)
+		}
+	}
+}
+
+func TestPathWhole(t *testing.T) {
+	data := decodeGeneric(t, pathDocument)
+
+	for _, expression := range []string{"", "$", ".", "{.}", " $ "} {
+		path, err := CompilePath(expression)
+		if err != nil {
+			t.Errorf("CompilePath(%q): %s", expression, err)
+			continue
+		}
+		if found := path.Find(data); len(found) != 1 {
+			t.Errorf("%q found %d values, want the whole document", expression, len(found))
+		}
+	}
+}
+
+func TestCompilePathInvalid(t *testing.T) {
+	for _, expression := range []string{
+		".items[",
+		".items[abc]",
+		".items[1:x]",
+		"..",
+		".items..",
+		".items.[",
+		"a b",
+		".items[0]x",
+		"['unterminated]",
+		"['unterminated",
+		".items[?(@.id == )]",
+		".items[?(@.id == nope)]",
+		".items[?(id == 1)]",
+		".items[?@.id == 1]",
+		".items[?(@.a b == 1)]",
+	} {
+		_, err := CompilePath(expression)
+		if err == nil {
+			t.Errorf("CompilePath(%q) succeeded, want an error", expression)
+			continue
+		}
+		if !errors.Is(err, errorTypes.LwCliInvalidFlagValue) {
+			t.Errorf("CompilePath(%q) error %q isn't an invalid flag value", expression, err)
+		}
+	}
+}
@@ -0,0 +1,166 @@
+/*
+Copyright © LiquidWeb
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package output
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"text/template"
+
+	"github.com/liquidweb/liquidweb-cli/types/errors"
+)
+
+// Format is how command results are printed.
+type Format string
+
+const (
+	// Text is the human readable output each command prints by default.
+	Text  Format = ""
+	Json  Format = "json"
+	Yaml  Format = "yaml"
+	Table Format = "table"
+	// Wide is Table including the columns declared Wide.
+	Wide Format = "wide"
+	Csv  Format = "csv"
+	// Template executes a Go template against the results, as decoded into apiTypes.
+	Template Format = "template"
+	// JsonPath prints the values a JSONPath expression picks out of the json output.
+	JsonPath Format = "jsonpath"
+	// Quiet prints only the identifier of each result, one per line. It is selected by
+	// --quiet rather than --output.
+	Quiet Format = "quiet"
+)
+
+// Formats lists the formats accepted by --output. Template and JsonPath take an
+// expression, as in "template=EXPRESSION".
+var Formats = []Format{Json, Yaml, Table, Wide, Csv, Template, JsonPath}
+
+// ParseFormat returns the Format named by value, and its expression for the formats
+// taking one. An empty value is Text.
+func ParseFormat(value string) (format Format, expression string, err error) {
+	if value == "" || value == "text" {
+		return
+	}
+
+	name := value
+	if i := strings.Index(value, "="); i != -1 {
+		name, expression = value[:i], value[i+1:]
+	}
+	format = Format(strings.ToLower(name))
+
+	switch format {
+	case Template:
+		_, err = compileTemplate(expression)
+		return
+	case JsonPath:
+		_, err = CompilePath(expression)
+		return
+	}
+
+	for _, known := range Formats {
+		if format == known && expression == "" {
+			return
+		}
+	}
+
+	return Text, "", fmt.Errorf("%w: unknown output format [%s]; must be one of %s",
+		errorTypes.LwCliInvalidFlagValue, value, FormatNames())
+}
+
+// FormatNames returns the accepted formats as "json|yaml|...".
+func FormatNames() string {
+	var names []string
+	for _, format := range Formats {
+		switch format {
+		case Template:
+			names = append(names, "template=TEMPLATE")
+		case JsonPath:
+			names = append(names, "jsonpath=PATH")
+		default:
+			names = append(names, string(format))
+		}
+	}
+
+	return strings.Join(names, "|")
+}
+
+// Encoded reports whether the format works on results as they'd be json encoded, rather
+// than printing them as rows or through a template.
+func (format Format) Encoded() bool {
+	return format == Json || format == Yaml || format == JsonPath
+}
+
+// Tabular reports whether results are printed as rows of columns.
+func (format Format) Tabular() bool {
+	return format == Table || format == Wide || format == Csv
+}
+
+// Itemized reports whether results are printed item by item, as rows or identifiers, so
+// commands should print the items of a list rather than the list response.
+func (format Format) Itemized() bool {
+	return format.Tabular() || format == Quiet
+}
+
+// Column is one column of a row printed by table, wide and csv output.
+type Column struct {
+	// Name is the column header. By convention it matches the json key of the field.
+	Name  string
+	Value interface{}
+	// Wide columns are left out of table output, but included in wide and csv output.
+	Wide bool
+}
+
+// Tabular is implemented by types declaring their own columns. Values of other types
+// get a column per top level json key.
+type Tabular interface {
+	TableColumns() []Column
+}
+
+// Identified is implemented by types whose identifier, as printed by Quiet output, isn't
+// their uniq_id, id or name json key, tried in that order.
+type Identified interface {
+	Identifier() string
+}
+
+// identifierKeys are the json keys Quiet output takes identifiers from.
+var identifierKeys = []string{"uniq_id", "id", "name"}
+
+// templateFuncs are available to --output template, in addition to the text/template
+// builtins.
+var templateFuncs = template.FuncMap{
+	"json": compactJson,
+	"join": func(separator string, values interface{}) string {
+		list := reflect.Indirect(reflect.ValueOf(values))
+		if list.Kind() != reflect.Slice && list.Kind() != reflect.Array {
+			return FormatValue(values)
+		}
+		var cells []string
+		for i := 0; i < list.Len(); i++ {
+			cells = append(cells, FormatValue(list.Index(i).Interface()))
+		}
+		return strings.Join(cells, separator)
+	},
+}
+
+func compileTemplate(text string) (*template.Template, error) {
+	parsed, err := template.New("output").Funcs(templateFuncs).Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid template: %s", errorTypes.LwCliInvalidFlagValue, err)
+	}
+
+	return parsed, nil
+}
@@ -0,0 +1,524 @@
+/*
+Copyright © LiquidWeb
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package output
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"text/template"
+
+	"gopkg.in/yaml.v2"
+
+	"github.com/liquidweb/liquidweb-cli/types/errors"
+)
+
+// Printer writes results in a Format. Json, yaml and text results are written as they
+// are printed; table, wide and csv rows are buffered so columns line up, and written
+// by Flush.
+type Printer struct {
+	Format Format
+	// Expression is the template or path of the Template and JsonPath formats.
+	Expression string
+	// Query, when set, is a path (see Path) picking what json and yaml output prints.
+	Query string
+	// Columns, when set, are the table, wide and csv columns printed, in order. Names
+	// that aren't columns of a row are looked up as paths in its json.
+	Columns []string
+	out     io.Writer
+
+	// list printers collect items given to Add, so json and yaml output is a single list.
+	list     bool
+	items    []interface{}
+	printed  int
+	path     *Path
+	template *template.Template
+	columns  []string
+	wide     map[string]bool
+	rows     []map[string]string
+}
+
+func NewPrinter(out io.Writer, format Format) *Printer {
+	return &Printer{
+		Format: format,
+		out:    out,
+		wide:   map[string]bool{},
+	}
+}
+
+// NewListPrinter returns a Printer for commands listing items with Add. Json and yaml
+// output is written by Flush as one list, which is empty when nothing was added.
+func NewListPrinter(out io.Writer, format Format) *Printer {
+	printer := NewPrinter(out, format)
+	printer.list = true
+	printer.items = []interface{}{}
+
+	return printer
+}
+
+// Print prints a result. Text output prints v with fmt, so its String method is used.
+// Tabular output prints a row per element when v is a slice, and a single row otherwise.
+func (p *Printer) Print(v interface{}) (err error) {
+	defer func() { p.printed++ }()
+
+	switch p.Format {
+	case Json:
+		err = p.printJson(v)
+	case Yaml:
+		err = p.printYaml(v)
+	case Table, Wide, Csv:
+		err = p.addRows(v)
+	case Template:
+		err = p.printTemplate(v)
+	case JsonPath:
+		err = p.printJsonPath(v)
+	case Quiet:
+		err = eachElement(v, p.printIdentifier)
+	default:
+		_, err = fmt.Fprint(p.out, v)
+	}
+
+	return
+}
+
+// Add adds an item of a list. Text output prints it straight away.
+func (p *Printer) Add(item interface{}) error {
+	if p.collects() {
+		p.items = append(p.items, item)
+		return nil
+	}
+
+	return p.Print(item)
+}
+
+// Flush writes the items collected by Add and any buffered rows.
+func (p *Printer) Flush() error {
+	if p.collects() {
+		items := p.items
+		p.items = []interface{}{}
+		return p.Print(items)
+	}
+
+	if !p.Format.Tabular() || len(p.rows) == 0 {
+		return nil
+	}
+
+	columns := p.Columns
+	for _, column := range p.columns {
+		if len(p.Columns) > 0 || (p.Format == Table && p.wide[column]) {
+			continue
+		}
+		columns = append(columns, column)
+	}
+
+	var err error
+	if p.Format == Csv {
+		err = p.writeCsv(columns)
+	} else {
+		err = p.writeTable(columns)
+	}
+	p.rows = nil
+
+	return err
+}
+
+// collects reports whether Add collects items for Flush rather than printing them.
+func (p *Printer) collects() bool {
+	return p.list && (p.Format.Encoded() || p.Format == Template)
+}
+
+func (p *Printer) printJson(v interface{}) error {
+	if p.Query != "" {
+		matches, err := p.query(p.Query, v)
+		if err != nil {
+			return err
+		}
+		// like jq, each match is printed as its own document
+		for _, match := range matches {
+			if err := p.encodeJson(match); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	return p.encodeJson(v)
+}
+
+func (p *Printer) encodeJson(v interface{}) error {
+	buffer := new(bytes.Buffer)
+	encoder := json.NewEncoder(buffer)
+	encoder.SetIndent("", "    ")
+	if err := encoder.Encode(v); err != nil {
+		return err
+	}
+
+	_, err := p.out.Write(buffer.Bytes())
+
+	return err
+}
+
+func (p *Printer) printYaml(v interface{}) error {
+	// round trip through json so keys are the json field names the api uses.
+	generic, err := toGeneric(v)
+	if err != nil {
+		return err
+	}
+	if p.Query != "" {
+		matches, err := p.query(p.Query, v)
+		if err != nil {
+			return err
+		}
+		generic = matches
+		if len(matches) == 1 {
+			generic = matches[0]
+		}
+	}
+
+	encoded, err := yaml.Marshal(generic)
+	if err != nil {
+		return err
+	}
+
+	if p.printed > 0 {
+		if _, err = io.WriteString(p.out, "---\n"); err != nil {
+			return err
+		}
+	}
+	_, err = p.out.Write(encoded)
+
+	return err
+}
+
+// printJsonPath prints each value Expression picks out of v on its own line. Objects and
+// lists are printed as compact json.
+func (p *Printer) printJsonPath(v interface{}) error {
+	matches, err := p.query(p.Expression, v)
+	if err != nil {
+		return err
+	}
+
+	for _, match := range matches {
+		line := FormatValue(match)
+		switch match.(type) {
+		case map[string]interface{}, []interface{}:
+			line = compactJson(match)
+		}
+		if _, err := fmt.Fprintln(p.out, line); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (p *Printer) query(expression string, v interface{}) ([]interface{}, error) {
+	if p.path == nil || p.path.String() != expression {
+		path, err := CompilePath(expression)
+		if err != nil {
+			return nil, err
+		}
+		p.path = path
+	}
+
+	generic, err := toGeneric(v)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.path.Find(generic), nil
+}
+
+func (p *Printer) printTemplate(v interface{}) (err error) {
+	if p.template == nil {
+		if p.template, err = compileTemplate(p.Expression); err != nil {
+			return
+		}
+	}
+
+	return p.template.Execute(p.out, v)
+}
+
+func (p *Printer) addRows(v interface{}) error {
+	return eachElement(v, p.addRow)
+}
+
+// eachElement calls fn with each element of v when it is a slice, or with v otherwise.
+func eachElement(v interface{}, fn func(interface{}) error) error {
+	value := reflect.ValueOf(v)
+	for value.Kind() == reflect.Ptr && !value.IsNil() {
+		if _, isTabular := value.Interface().(Tabular); isTabular {
+			break
+		}
+		if _, isIdentified := value.Interface().(Identified); isIdentified {
+			break
+		}
+		value = value.Elem()
+	}
+
+	if value.Kind() == reflect.Slice || value.Kind() == reflect.Array {
+		for i := 0; i < value.Len(); i++ {
+			if err := fn(value.Index(i).Interface()); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	return fn(v)
+}
+
+func (p *Printer) printIdentifier(v interface{}) error {
+	if identified, ok := v.(Identified); ok && identified.Identifier() != "" {
+		_, err := fmt.Fprintln(p.out, identified.Identifier())
+		return err
+	}
+
+	generic, err := toGeneric(v)
+	if err != nil {
+		return err
+	}
+	if object, isObject := generic.(map[string]interface{}); isObject {
+		for _, key := range identifierKeys {
+			if value, found := object[key]; found && value != nil && FormatValue(value) != "" {
+				_, err := fmt.Fprintln(p.out, FormatValue(value))
+				return err
+			}
+		}
+	}
+
+	return fmt.Errorf("%w: --quiet can't print an identifier for %T results", errorTypes.LwCliInvalidFlagValue, v)
+}
+
+func (p *Printer) addRow(v interface{}) error {
+	columns, err := tableColumns(v)
+	if err != nil {
+		return err
+	}
+
+	row := map[string]string{}
+	for _, column := range columns {
+		if _, seen := p.wide[column.Name]; !seen {
+			p.columns = append(p.columns, column.Name)
+			p.wide[column.Name] = column.Wide
+		}
+		row[column.Name] = FormatValue(column.Value)
+	}
+	if err := p.addPathColumns(v, row); err != nil {
+		return err
+	}
+	p.rows = append(p.rows, row)
+
+	return nil
+}
+
+// addPathColumns fills in the Columns missing from row by looking them up as paths.
+func (p *Printer) addPathColumns(v interface{}, row map[string]string) error {
+	var generic interface{}
+	for _, name := range p.Columns {
+		if _, found := row[name]; found {
+			continue
+		}
+
+		path, err := CompilePath(name)
+		if err != nil {
+			return err
+		}
+		if generic == nil {
+			if generic, err = toGeneric(v); err != nil {
+				return err
+			}
+		}
+
+		var values []string
+		for _, value := range path.Find(generic) {
+			values = append(values, FormatValue(value))
+		}
+		row[name] = strings.Join(values, ",")
+	}
+
+	return nil
+}
+
+func (p *Printer) writeTable(columns []string) error {
+	writer := tabwriter.NewWriter(p.out, 0, 0, 3, ' ', 0)
+
+	var headers []string
+	for _, column := range columns {
+		headers = append(headers, strings.ToUpper(column))
+	}
+	fmt.Fprintln(writer, strings.Join(headers, "\t"))
+
+	for _, row := range p.rows {
+		var cells []string
+		for _, column := range columns {
+			// tabs and newlines would break the alignment
+			cells = append(cells, strings.NewReplacer("\t", " ", "\n", " ").Replace(row[column]))
+		}
+		fmt.Fprintln(writer, strings.Join(cells, "\t"))
+	}
+
+	return writer.Flush()
+}
+
+func (p *Printer) writeCsv(columns []string) error {
+	writer := csv.NewWriter(p.out)
+	if err := writer.Write(columns); err != nil {
+		return err
+	}
+
+	for _, row := range p.rows {
+		var cells []string
+		for _, column := range columns {
+			cells = append(cells, row[column])
+		}
+		if err := writer.Write(cells); err != nil {
+			return err
+		}
+	}
+	writer.Flush()
+
+	return writer.Error()
+}
+
+// tableColumns returns the columns of v; either the ones it declares, or one per top
+// level json key, with nested objects and lists left to wide output.
+func tableColumns(v interface{}) ([]Column, error) {
+	if tabular, ok := v.(Tabular); ok {
+		return tabular.TableColumns(), nil
+	}
+
+	generic, err := toGeneric(v)
+	if err != nil {
+		return nil, err
+	}
+
+	object, ok := generic.(map[string]interface{})
+	if !ok {
+		return []Column{{Name: "value", Value: generic}}, nil
+	}
+
+	var columns []Column
+	for _, key := range sortedKeys(object) {
+		columns = append(columns, Column{Name: key, Value: object[key], Wide: isNested(object[key])})
+	}
+
+	return columns, nil
+}
+
+// isNested reports whether a json value is an object, or a list holding objects or
+// lists.
+func isNested(v interface{}) bool {
+	switch typed := v.(type) {
+	case map[string]interface{}:
+		return true
+	case []interface{}:
+		for _, element := range typed {
+			switch element.(type) {
+			case map[string]interface{}, []interface{}:
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func sortedKeys(object map[string]interface{}) []string {
+	var keys []string
+	for key := range object {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
+
+func toGeneric(v interface{}) (generic interface{}, err error) {
+	encoded, err := json.Marshal(v)
+	if err != nil {
+		return
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(encoded))
+	decoder.UseNumber()
+	err = decoder.Decode(&generic)
+
+	return
+}
+
+// FormatValue renders a column value as a single line of text. Lists of scalars are
+// joined with ",", other lists and objects are compact json.
+func FormatValue(v interface{}) string {
+	if v == nil {
+		return ""
+	}
+
+	value := reflect.ValueOf(v)
+	for value.Kind() == reflect.Ptr {
+		if value.IsNil() {
+			return ""
+		}
+		value = value.Elem()
+	}
+
+	switch value.Kind() {
+	case reflect.String:
+		return value.String()
+	case reflect.Bool:
+		return strconv.FormatBool(value.Bool())
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(value.Int(), 10)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(value.Uint(), 10)
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(value.Float(), 'f', -1, 64)
+	case reflect.Slice, reflect.Array:
+		var cells []string
+		for i := 0; i < value.Len(); i++ {
+			element := reflect.Indirect(value.Index(i))
+			if element.Kind() == reflect.Interface {
+				element = reflect.Indirect(element.Elem())
+			}
+			switch element.Kind() {
+			case reflect.Map, reflect.Struct, reflect.Slice, reflect.Array, reflect.Invalid:
+				return compactJson(v)
+			}
+			cells = append(cells, FormatValue(element.Interface()))
+		}
+		return strings.Join(cells, ",")
+	case reflect.Map, reflect.Struct:
+		return compactJson(v)
+	}
+
+	return fmt.Sprintf("%v", v)
+}
+
+func compactJson(v interface{}) string {
+	encoded, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprintf("%v", v)
+	}
+
+	return string(encoded)
+}
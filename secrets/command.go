@@ -0,0 +1,67 @@
+/*
+Copyright © LiquidWeb
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package secrets
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+)
+
+// passwordCommand gets passwords from a user supplied command, run through the shell.
+// The command owns the password, so it can't be written to.
+type passwordCommand struct {
+	command string
+}
+
+func (c passwordCommand) Get(context string) (password string, err error) {
+	shell, flag := "sh", "-c"
+	if runtime.GOOS == "windows" {
+		shell, flag = "cmd", "/C"
+	}
+
+	cmd := exec.Command(shell, flag, c.command)
+	cmd.Env = append(os.Environ(), fmt.Sprintf("%s=%s", CommandContextEnv, context))
+	// let the command prompt, e.g. for a gpg passphrase
+	cmd.Stdin = os.Stdin
+	cmd.Stderr = os.Stderr
+
+	var out []byte
+	out, err = cmd.Output()
+	if err != nil {
+		err = fmt.Errorf("%w [%s]: %s", ErrorCommandFailed, c.command, err)
+		return
+	}
+
+	password = strings.TrimRight(string(out), "\r\n")
+	if password == "" {
+		err = fmt.Errorf("%w [%s]: it printed no password", ErrorCommandFailed, c.command)
+	}
+
+	return
+}
+
+func (c passwordCommand) Set(context, password string) error {
+	return fmt.Errorf("%w: the password of auth context [%s] is managed by its password command", ErrorReadOnly,
+		context)
+}
+
+// Delete does nothing; the password belongs to whatever the command reads it from.
+func (c passwordCommand) Delete(context string) error {
+	return nil
+}
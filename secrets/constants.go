@@ -0,0 +1,56 @@
+/*
+Copyright © LiquidWeb
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package secrets
+
+// Stores an auth context password can be kept in.
+const (
+	// Keyring is the Secret Service (GNOME Keyring, KWallet, ...) reached over D-Bus.
+	Keyring = "keyring"
+	// Vault is a local file encrypted with a passphrase.
+	Vault = "vault"
+	// Command runs a user supplied command printing the password, such as 'pass show lw'.
+	Command = "command"
+	// Plaintext keeps the password in the config file. Only used when asked for.
+	Plaintext = "plaintext"
+	// Auto picks Keyring when it is available, else Vault.
+	Auto = "auto"
+)
+
+// Stores lists the stores that can be asked for by name.
+var Stores = []string{Auto, Keyring, Vault, Command, Plaintext}
+
+// ServiceName is the service attribute passwords are saved under in the keyring.
+const ServiceName = "liquidweb-cli"
+
+// VaultPassphraseEnv is the environment variable the vault passphrase is taken from.
+// When unset, it is prompted for on the terminal.
+const VaultPassphraseEnv = "LW_VAULT_PASSPHRASE"
+
+// CommandContextEnv is set to the name of the auth context when running a password
+// command, so one command can serve several contexts.
+const CommandContextEnv = "LW_PASSWORD_CONTEXT"
+
+// ExportPassphraseEnv is the environment variable the passphrase of encrypted context
+// exports is taken from. When unset, it is prompted for on the terminal.
+const ExportPassphraseEnv = "LW_EXPORT_PASSPHRASE"
+
+// scrypt parameters deriving the key of Sealed data from its passphrase.
+const (
+	scryptN     = 1 << 15
+	scryptR     = 8
+	scryptP     = 1
+	sealVersion = 1
+)
@@ -0,0 +1,89 @@
+/*
+Copyright © LiquidWeb
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package secrets
+
+import (
+	"fmt"
+	"os/exec"
+	"strings"
+)
+
+// Store keeps the passwords of auth contexts, by context name.
+type Store interface {
+	Get(context string) (password string, err error)
+	Set(context, password string) error
+	Delete(context string) error
+}
+
+// Resolve returns the store name asked for, with Auto (or no name) resolved to the
+// store that would be used.
+func Resolve(store string) (string, error) {
+	switch store {
+	case "", Auto:
+		if KeyringAvailable() {
+			return Keyring, nil
+		}
+		return Vault, nil
+	case Keyring, Vault, Command, Plaintext:
+		return store, nil
+	}
+
+	return "", fmt.Errorf("%w [%s]; must be one of %s", ErrorUnknownStore, store, strings.Join(Stores, ", "))
+}
+
+// New returns the named store. command is the password command of the Command store.
+// Plaintext has no store; its passwords live in the config file.
+func New(store, command string) (Store, error) {
+	switch store {
+	case Keyring:
+		if _, err := exec.LookPath("secret-tool"); err != nil {
+			return nil, ErrorNoSecretTool
+		}
+		if !KeyringAvailable() {
+			return nil, ErrorKeyringUnavailable
+		}
+		return keyring{}, nil
+	case Vault:
+		file, err := VaultFile()
+		if err != nil {
+			return nil, err
+		}
+		return vault{file: file}, nil
+	case Command:
+		if strings.TrimSpace(command) == "" {
+			return nil, fmt.Errorf("%w: the command store needs a password command", ErrorUnknownStore)
+		}
+		return passwordCommand{command: command}, nil
+	}
+
+	return nil, fmt.Errorf("%w [%s]", ErrorUnknownStore, store)
+}
+
+// Password returns the password of an auth context kept in store. plaintext is the
+// password found in the config file, which is used as is when store is Plaintext or
+// unset, as it is for contexts created before passwords could be stored elsewhere.
+func Password(context, store, command, plaintext string) (string, error) {
+	if store == "" || store == Plaintext {
+		return plaintext, nil
+	}
+
+	s, err := New(store, command)
+	if err != nil {
+		return "", err
+	}
+
+	return s.Get(context)
+}
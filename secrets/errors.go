@@ -0,0 +1,31 @@
+/*
+Copyright © LiquidWeb
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package secrets
+
+import (
+	"errors"
+)
+
+var ErrorUnknownStore = errors.New("unknown password store")
+var ErrorKeyringUnavailable = errors.New("no Secret Service keyring is available; is a D-Bus session running?")
+var ErrorNoSecretTool = errors.New("the keyring password store needs secret-tool, from libsecret (e.g. the libsecret-tools package); install it or use another --password-store")
+var ErrorKeyring = errors.New("keyring request failed")
+var ErrorNotFound = errors.New("no password saved for auth context")
+var ErrorVaultLocked = errors.New("unable to unlock the password vault")
+var ErrorReadOnly = errors.New("password store can't be written to")
+var ErrorCommandFailed = errors.New("password command failed")
+var ErrorUnsealing = errors.New("unable to decrypt")
+var ErrorPassphrase = errors.New("unable to read the passphrase")
@@ -0,0 +1,103 @@
+/*
+Copyright © LiquidWeb
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package secrets
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+)
+
+// keyring talks to the Secret Service through secret-tool, the libsecret command line
+// client, rather than speaking D-Bus itself; the keyring store needs it installed.
+type keyring struct{}
+
+// KeyringAvailable reports whether a Secret Service keyring can be used.
+func KeyringAvailable() bool {
+	if os.Getenv("DBUS_SESSION_BUS_ADDRESS") == "" {
+		return false
+	}
+	_, err := exec.LookPath("secret-tool")
+
+	return err == nil
+}
+
+func (keyring) Get(context string) (password string, err error) {
+	var out []byte
+	out, err = keyringRun(nil, true, append([]string{"lookup"}, keyringAttributes(context)...)...)
+	if err != nil {
+		return
+	}
+
+	// secret-tool lookup quietly exits 1 when nothing matched
+	if len(out) == 0 {
+		err = fmt.Errorf("%w [%s] in the keyring", ErrorNotFound, context)
+		return
+	}
+	password = string(out)
+
+	return
+}
+
+func (keyring) Set(context, password string) (err error) {
+	args := append([]string{"store", "--label", fmt.Sprintf("LiquidWeb API password (%s)", context)},
+		keyringAttributes(context)...)
+	_, err = keyringRun(strings.NewReader(password), false, args...)
+
+	return
+}
+
+func (keyring) Delete(context string) (err error) {
+	_, err = keyringRun(nil, false, append([]string{"clear"}, keyringAttributes(context)...)...)
+
+	return
+}
+
+func keyringAttributes(context string) []string {
+	return []string{"service", ServiceName, "context", context}
+}
+
+// keyringRun runs secret-tool with args. A non-zero exit is a failure, unless
+// missingOk is set and secret-tool said nothing, as a lookup finding nothing does.
+func keyringRun(stdin *strings.Reader, missingOk bool, args ...string) (out []byte, err error) {
+	if _, err = exec.LookPath("secret-tool"); err != nil {
+		err = ErrorNoSecretTool
+		return
+	}
+
+	cmd := exec.Command("secret-tool", args...)
+	if stdin != nil {
+		cmd.Stdin = stdin
+	}
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+
+	out, err = cmd.Output()
+	if err != nil {
+		var exitErr *exec.ExitError
+		if missingOk && errors.As(err, &exitErr) && stderr.Len() == 0 {
+			err = nil
+			return
+		}
+		err = fmt.Errorf("%w: secret-tool %s: %s %s", ErrorKeyring, args[0], err,
+			strings.TrimSpace(stderr.String()))
+	}
+
+	return
+}
@@ -0,0 +1,137 @@
+/*
+Copyright © LiquidWeb
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package secrets
+
+import (
+	"crypto/cipher"
+	"crypto/rand"
+	"fmt"
+	"os"
+
+	"golang.org/x/crypto/chacha20poly1305"
+	"golang.org/x/crypto/scrypt"
+	"golang.org/x/crypto/ssh/terminal"
+)
+
+// Sealed is data encrypted with XChaCha20-Poly1305 under a key derived from a
+// passphrase with scrypt, as kept in the vault and in encrypted context exports.
+type Sealed struct {
+	Version    int    `json:"version"`
+	Salt       []byte `json:"salt"`
+	Nonce      []byte `json:"nonce"`
+	Ciphertext []byte `json:"ciphertext"`
+}
+
+// Seal encrypts plaintext with passphrase, under a fresh salt and nonce.
+func Seal(passphrase string, plaintext []byte) (sealed Sealed, err error) {
+	sealed = Sealed{
+		Version: sealVersion,
+		Salt:    make([]byte, 16),
+		Nonce:   make([]byte, chacha20poly1305.NonceSizeX),
+	}
+	if _, err = rand.Read(sealed.Salt); err != nil {
+		return
+	}
+	if _, err = rand.Read(sealed.Nonce); err != nil {
+		return
+	}
+
+	aead, err := sealAead(passphrase, sealed.Salt)
+	if err != nil {
+		return
+	}
+	sealed.Ciphertext = aead.Seal(nil, sealed.Nonce, plaintext, nil)
+
+	return
+}
+
+// Open decrypts sealed with passphrase. A wrong passphrase and tampered data are
+// told apart from neither each other nor any other failure.
+func (sealed Sealed) Open(passphrase string) (plaintext []byte, err error) {
+	if sealed.Version != sealVersion {
+		err = fmt.Errorf("%w: unknown version [%d]", ErrorUnsealing, sealed.Version)
+		return
+	}
+	// the aead panics on a nonce of the wrong size
+	if len(sealed.Nonce) != chacha20poly1305.NonceSizeX {
+		err = fmt.Errorf("%w: the data was tampered with", ErrorUnsealing)
+		return
+	}
+
+	aead, err := sealAead(passphrase, sealed.Salt)
+	if err != nil {
+		return
+	}
+	plaintext, err = aead.Open(nil, sealed.Nonce, sealed.Ciphertext, nil)
+	if err != nil {
+		err = fmt.Errorf("%w: wrong passphrase, or the data was tampered with", ErrorUnsealing)
+	}
+
+	return
+}
+
+func sealAead(passphrase string, salt []byte) (aead cipher.AEAD, err error) {
+	key, err := scrypt.Key([]byte(passphrase), salt, scryptN, scryptR, scryptP, chacha20poly1305.KeySize)
+	if err != nil {
+		return
+	}
+
+	return chacha20poly1305.NewX(key)
+}
+
+// ReadPassphrase returns the passphrase in the environment variable env, else prompts
+// for it on the terminal with prompt. When confirm is set, it is asked for twice.
+func ReadPassphrase(env, prompt string, confirm bool) (passphrase string, err error) {
+	if passphrase = os.Getenv(env); passphrase != "" {
+		return
+	}
+
+	stdin := int(os.Stdin.Fd())
+	if !terminal.IsTerminal(stdin) {
+		err = fmt.Errorf("%w: set %s, or run from a terminal to be prompted for the passphrase",
+			ErrorPassphrase, env)
+		return
+	}
+
+	fmt.Fprint(os.Stderr, prompt)
+	entered, err := terminal.ReadPassword(stdin)
+	fmt.Fprintln(os.Stderr)
+	if err != nil {
+		return
+	}
+	if len(entered) == 0 {
+		err = fmt.Errorf("%w: the passphrase can't be empty", ErrorPassphrase)
+		return
+	}
+
+	if confirm {
+		fmt.Fprint(os.Stderr, "Repeat the passphrase: ")
+		repeated, repeatErr := terminal.ReadPassword(stdin)
+		fmt.Fprintln(os.Stderr)
+		if repeatErr != nil {
+			err = repeatErr
+			return
+		}
+		if string(repeated) != string(entered) {
+			err = fmt.Errorf("%w: the passphrases didn't match", ErrorPassphrase)
+			return
+		}
+	}
+
+	passphrase = string(entered)
+
+	return
+}
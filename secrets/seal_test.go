@@ -0,0 +1,127 @@
+/*
+Copyright © LiquidWeb
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package secrets
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+func TestSealRoundTrip(t *testing.T) {
+	for _, plaintext := range [][]byte{
+		[]byte("hunter2"),
+		[]byte(`{"contexts":{"prod":"s3cret"}}`),
+		{},
+		bytes.Repeat([]byte{0, 0xff}, 4096),
+	} {
+		sealed, err := Seal("passphrase", plaintext)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if bytes.Contains(sealed.Ciphertext, plaintext) && len(plaintext) > 0 {
+			t.Errorf("ciphertext contains the plaintext %q", plaintext)
+		}
+
+		// sealed data is kept as json, so round trip it through json too
+		encoded, err := json.Marshal(sealed)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var decoded Sealed
+		if err := json.Unmarshal(encoded, &decoded); err != nil {
+			t.Fatal(err)
+		}
+
+		opened, err := decoded.Open("passphrase")
+		if err != nil {
+			t.Fatalf("opening sealed %q: %s", plaintext, err)
+		}
+		if !bytes.Equal(opened, plaintext) {
+			t.Errorf("opened %q, want %q", opened, plaintext)
+		}
+	}
+}
+
+func TestSealFreshSaltAndNonce(t *testing.T) {
+	first, err := Seal("passphrase", []byte("hunter2"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	second, err := Seal("passphrase", []byte("hunter2"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if bytes.Equal(first.Salt, second.Salt) {
+		t.Error("two seals share a salt")
+	}
+	if bytes.Equal(first.Nonce, second.Nonce) {
+		t.Error("two seals share a nonce")
+	}
+	if bytes.Equal(first.Ciphertext, second.Ciphertext) {
+		t.Error("two seals of the same plaintext share a ciphertext")
+	}
+}
+
+func TestOpenRejects(t *testing.T) {
+	sealed, err := Seal("passphrase", []byte("hunter2"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	flipped := func(data []byte, i int) []byte {
+		tampered := append([]byte(nil), data...)
+		tampered[i] ^= 0x01
+		return tampered
+	}
+
+	tests := []struct {
+		name       string
+		sealed     Sealed
+		passphrase string
+	}{
+		{"wrong passphrase", sealed, "Passphrase"},
+		{"empty passphrase", sealed, ""},
+		{"tampered ciphertext", Sealed{sealed.Version, sealed.Salt, sealed.Nonce, flipped(sealed.Ciphertext, 0)},
+			"passphrase"},
+		{"tampered tag", Sealed{sealed.Version, sealed.Salt, sealed.Nonce,
+			flipped(sealed.Ciphertext, len(sealed.Ciphertext)-1)}, "passphrase"},
+		{"truncated ciphertext", Sealed{sealed.Version, sealed.Salt, sealed.Nonce,
+			sealed.Ciphertext[:len(sealed.Ciphertext)-1]}, "passphrase"},
+		{"missing ciphertext", Sealed{sealed.Version, sealed.Salt, sealed.Nonce, nil}, "passphrase"},
+		{"tampered nonce", Sealed{sealed.Version, sealed.Salt, flipped(sealed.Nonce, 3), sealed.Ciphertext},
+			"passphrase"},
+		{"short nonce", Sealed{sealed.Version, sealed.Salt, sealed.Nonce[:12], sealed.Ciphertext}, "passphrase"},
+		{"missing nonce", Sealed{sealed.Version, sealed.Salt, nil, sealed.Ciphertext}, "passphrase"},
+		{"tampered salt", Sealed{sealed.Version, flipped(sealed.Salt, 0), sealed.Nonce, sealed.Ciphertext},
+			"passphrase"},
+		{"unknown version", Sealed{sealed.Version + 1, sealed.Salt, sealed.Nonce, sealed.Ciphertext},
+			"passphrase"},
+	}
+
+	for _, test := range tests {
+		opened, err := test.sealed.Open(test.passphrase)
+		if err == nil {
+			t.Errorf("%s: opened %q, want an error", test.name, opened)
+			continue
+		}
+		if !errors.Is(err, ErrorUnsealing) {
+			t.Errorf("%s: error %q isn't ErrorUnsealing", test.name, err)
+		}
+	}
+}
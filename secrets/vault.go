@@ -0,0 +1,219 @@
+/*
+Copyright © LiquidWeb
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package secrets
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/mitchellh/go-homedir"
+)
+
+// vault keeps passwords in a file, as a json map of context name to password, Sealed
+// with a passphrase.
+type vault struct {
+	file string
+}
+
+// vaultPassphrase is kept once entered, so a command is only prompted once.
+var vaultPassphrase string
+
+// LockFile takes an advisory lock on file, held until unlock is called. config, which
+// imports this package, sets it to its own Lock, so the vault and config files are
+// locked alike.
+var LockFile = func(file string) (unlock func(), err error) {
+	return func() {}, nil
+}
+
+// VaultFile returns the path of the vault.
+func VaultFile() (file string, err error) {
+	var home string
+	home, err = homedir.Dir()
+	if err != nil {
+		return
+	}
+	file = filepath.Join(home, ".liquidweb-cli-vault")
+
+	return
+}
+
+func (v vault) Get(context string) (password string, err error) {
+	var passwords map[string]string
+	if passwords, err = v.read(); err != nil {
+		return
+	}
+
+	var found bool
+	if password, found = passwords[context]; !found {
+		err = fmt.Errorf("%w [%s] in vault [%s]", ErrorNotFound, context, v.file)
+	}
+
+	return
+}
+
+func (v vault) Set(context, password string) error {
+	return v.edit(true, func(passwords map[string]string) bool {
+		passwords[context] = password
+		return true
+	})
+}
+
+func (v vault) Delete(context string) error {
+	return v.edit(false, func(passwords map[string]string) bool {
+		if _, found := passwords[context]; !found {
+			return false
+		}
+		delete(passwords, context)
+		return true
+	})
+}
+
+// edit applies edit to the passwords in the vault, and writes them back when it
+// reports a change. The vault is locked from reading it to writing it, so concurrent lw
+// processes don't lose each other's passwords. The passphrase is asked for first, so
+// no prompt holds the lock. A missing vault is only created when create is set.
+func (v vault) edit(create bool, edit func(passwords map[string]string) (changed bool)) (err error) {
+	_, statErr := os.Stat(v.file)
+	if os.IsNotExist(statErr) && !create {
+		return
+	}
+	if _, err = readVaultPassphrase(os.IsNotExist(statErr)); err != nil {
+		return
+	}
+
+	unlock, err := LockFile(v.file)
+	if err != nil {
+		return
+	}
+	defer unlock()
+
+	var passwords map[string]string
+	if passwords, err = v.read(); err != nil {
+		return
+	}
+	if !edit(passwords) {
+		return
+	}
+
+	err = v.write(passwords)
+
+	return
+}
+
+// read returns the passwords in the vault; none when it doesn't exist yet.
+func (v vault) read() (passwords map[string]string, err error) {
+	passwords = map[string]string{}
+
+	data, err := ioutil.ReadFile(filepath.Clean(v.file))
+	if err != nil {
+		if os.IsNotExist(err) {
+			err = nil
+		}
+		return
+	}
+
+	var contents Sealed
+	if err = json.Unmarshal(data, &contents); err != nil {
+		err = fmt.Errorf("%w: vault [%s] is corrupt: %s", ErrorVaultLocked, v.file, err)
+		return
+	}
+
+	var passphrase string
+	if passphrase, err = readVaultPassphrase(false); err != nil {
+		return
+	}
+
+	plaintext, err := contents.Open(passphrase)
+	if err != nil {
+		err = fmt.Errorf("%w [%s]: %s", ErrorVaultLocked, v.file, err)
+		return
+	}
+
+	err = json.Unmarshal(plaintext, &passwords)
+
+	return
+}
+
+// write seals passwords into the vault, with a fresh salt and nonce. The file is
+// replaced by a rename, so a failed write never leaves a truncated vault behind.
+func (v vault) write(passwords map[string]string) (err error) {
+	_, statErr := os.Stat(v.file)
+
+	var passphrase string
+	if passphrase, err = readVaultPassphrase(os.IsNotExist(statErr)); err != nil {
+		return
+	}
+
+	plaintext, err := json.Marshal(passwords)
+	if err != nil {
+		return
+	}
+	contents, err := Seal(passphrase, plaintext)
+	if err != nil {
+		return
+	}
+
+	data, err := json.MarshalIndent(contents, "", " ")
+	if err != nil {
+		return
+	}
+
+	tmp, err := ioutil.TempFile(filepath.Dir(v.file), ".liquidweb-cli-vault-")
+	if err != nil {
+		return
+	}
+	defer os.Remove(tmp.Name())
+
+	if err = tmp.Chmod(0600); err != nil {
+		_ = tmp.Close()
+		return
+	}
+	if _, err = tmp.Write(data); err != nil {
+		_ = tmp.Close()
+		return
+	}
+	if err = tmp.Close(); err != nil {
+		return
+	}
+
+	err = os.Rename(tmp.Name(), v.file)
+
+	return
+}
+
+// readVaultPassphrase returns the vault passphrase from VaultPassphraseEnv, else prompts
+// for it. A new vault's passphrase is asked for twice.
+func readVaultPassphrase(creating bool) (passphrase string, err error) {
+	if vaultPassphrase != "" && os.Getenv(VaultPassphraseEnv) == "" {
+		passphrase = vaultPassphrase
+		return
+	}
+
+	prompt := "Vault passphrase: "
+	if creating {
+		prompt = "Passphrase for the new password vault: "
+	}
+	if passphrase, err = ReadPassphrase(VaultPassphraseEnv, prompt, creating); err != nil {
+		err = fmt.Errorf("%w: %s", ErrorVaultLocked, err)
+		return
+	}
+	vaultPassphrase = passphrase
+
+	return
+}
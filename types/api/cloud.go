@@ -17,7 +17,10 @@ package apiTypes
 
 import (
 	"fmt"
+	"sort"
 	"strings"
+
+	"github.com/liquidweb/liquidweb-cli/output"
 )
 
 type CloudServerStatus struct {
@@ -113,6 +116,31 @@ func (x CloudServerDetails) String() string {
 	return strings.Join(slice[:], "")
 }
 
+func (x CloudServerDetails) TableColumns() []output.Column {
+	return []output.Column{
+		{Name: "uniq_id", Value: x.UniqId},
+		{Name: "domain", Value: x.Domain},
+		{Name: "ip", Value: x.Ip},
+		{Name: "zone", Value: x.Zone.Name},
+		{Name: "config_id", Value: x.ConfigId},
+		{Name: "vcpu", Value: x.Vcpu},
+		{Name: "memory", Value: x.Memory},
+		{Name: "diskspace", Value: x.DiskSpace},
+		{Name: "template", Value: x.Template},
+		{Name: "region", Value: x.Zone.Region.Name, Wide: true},
+		{Name: "config_description", Value: x.ConfigDescription, Wide: true},
+		{Name: "template_description", Value: x.TemplateDescription, Wide: true},
+		{Name: "parent", Value: x.PrivateParent, Wide: true},
+		{Name: "ip_count", Value: x.IpCount, Wide: true},
+		{Name: "backup_enabled", Value: x.BackupEnabled, Wide: true},
+		{Name: "bandwidth_quota", Value: x.BandwidthQuota, Wide: true},
+		{Name: "manage_level", Value: x.ManageLevel, Wide: true},
+		{Name: "create_date", Value: x.CreateDate, Wide: true},
+		{Name: "active", Value: x.Active, Wide: true},
+		{Name: "accnt", Value: x.Accnt, Wide: true},
+	}
+}
+
 type CloudPrivateParentDetails struct {
 	Accnt             int64                                     `json:"accnt" mapstructure:"accnt"`
 	BucketUniqId      string                                    `json:"bucket_uniq_id" mapstructure:"bucket_uniq_id"`
@@ -198,6 +226,26 @@ func (x CloudPrivateParentDetails) String() string {
 	return strings.Join(slice[:], "")
 }
 
+func (x CloudPrivateParentDetails) TableColumns() []output.Column {
+	return []output.Column{
+		{Name: "uniq_id", Value: x.UniqId},
+		{Name: "domain", Value: x.Domain},
+		{Name: "status", Value: x.Status},
+		{Name: "config_id", Value: x.ConfigId},
+		{Name: "vcpu", Value: x.Vcpu},
+		{Name: "zone", Value: x.Zone.Name},
+		{Name: "config_description", Value: x.ConfigDescription, Wide: true},
+		{Name: "region", Value: x.Zone.Region.Name, Wide: true},
+		{Name: "hv_type", Value: x.Zone.HvType, Wide: true},
+		{Name: "diskspace_used", Value: x.Resources.DiskSpace.Used, Wide: true},
+		{Name: "diskspace_total", Value: x.Resources.DiskSpace.Total, Wide: true},
+		{Name: "memory_used", Value: x.Resources.Memory.Used, Wide: true},
+		{Name: "memory_total", Value: x.Resources.Memory.Total, Wide: true},
+		{Name: "create_date", Value: x.CreateDate, Wide: true},
+		{Name: "license_state", Value: x.LicenseState, Wide: true},
+	}
+}
+
 type CloudConfigDetails struct {
 	Id                int64         `json:"id" mapstructure:"id"`
 	Active            int64         `json:"active" mapstructure:"active"`
@@ -223,6 +271,30 @@ type CloudConfigDetails struct {
 	CpuCores          int64         `json:"cpu_cores,omitempty" mapstructure:"cpu_cores"`
 }
 
+func (x CloudConfigDetails) TableColumns() []output.Column {
+	return []output.Column{
+		{Name: "id", Value: x.Id},
+		{Name: "category", Value: x.Category},
+		{Name: "description", Value: x.Description},
+		{Name: "vcpu", Value: x.Vcpu},
+		{Name: "memory", Value: x.Memory},
+		{Name: "disk", Value: x.Disk},
+		{Name: "available", Value: x.Available, Wide: true},
+		{Name: "featured", Value: x.Featured, Wide: true},
+		{Name: "cpu_model", Value: x.CpuModel, Wide: true},
+		{Name: "cpu_count", Value: x.CpuCount, Wide: true},
+		{Name: "cpu_cores", Value: x.CpuCores, Wide: true},
+		{Name: "ram_total", Value: x.RamTotal, Wide: true},
+		{Name: "disk_type", Value: x.DiskType, Wide: true},
+		{Name: "disk_total", Value: x.DiskTotal, Wide: true},
+		{Name: "raid_level", Value: x.RaidLevel, Wide: true},
+	}
+}
+
+type CloudServerCreateResponse struct {
+	UniqId string `json:"uniq_id" mapstructure:"uniq_id"`
+}
+
 type CloudServerDestroyResponse struct {
 	Destroyed string `json:"destroyed" mapstructure:"destroyed"`
 }
@@ -243,8 +315,12 @@ type CloudImageCreateResponse struct {
 	Created string `json:"created" mapstructure:"created"`
 }
 
+func (x CloudImageCreateResponse) Identifier() string {
+	return x.Created
+}
+
 type CloudImageRestoreResponse struct {
-	Reimaged string `json"reimaged" mapstructure:"reimaged"`
+	Reimaged string `json:"reimaged" mapstructure:"reimaged"`
 }
 
 type CloudBackupRestoreResponse struct {
@@ -259,6 +335,38 @@ type CloudTemplateRestoreResponse struct {
 	Reimaged string `json:"reimaged" mapstructure:"reimaged"`
 }
 
+type CloudTemplateDetails struct {
+	Id               int64            `json:"id" mapstructure:"id"`
+	Name             string           `json:"name" mapstructure:"name"`
+	Description      string           `json:"description" mapstructure:"description"`
+	Os               string           `json:"os" mapstructure:"os"`
+	ManageLevel      string           `json:"manage_level" mapstructure:"manage_level"`
+	Deprecated       int64            `json:"deprecated" mapstructure:"deprecated"`
+	ZoneAvailability map[string]int64 `json:"zone_availability" mapstructure:"zone_availability"`
+}
+
+func (x CloudTemplateDetails) TableColumns() []output.Column {
+	var zones []string
+	for zone := range x.ZoneAvailability {
+		zones = append(zones, zone)
+	}
+	sort.Strings(zones)
+
+	return []output.Column{
+		{Name: "name", Value: x.Name},
+		{Name: "description", Value: x.Description},
+		{Name: "os", Value: x.Os},
+		{Name: "manage_level", Value: x.ManageLevel},
+		{Name: "id", Value: x.Id, Wide: true},
+		{Name: "zone_availability", Value: zones, Wide: true},
+	}
+}
+
+// Templates are referred to by name.
+func (x CloudTemplateDetails) Identifier() string {
+	return x.Name
+}
+
 type CloudServerIsBlockStorageOptimized struct {
 	IsOptimized bool `json:"is_optimized" mapstructure:"is_optimized"`
 }
@@ -349,6 +457,21 @@ func (x CloudImageDetails) String() string {
 	return strings.Join(slice[:], "")
 }
 
+func (x CloudImageDetails) TableColumns() []output.Column {
+	return []output.Column{
+		{Name: "id", Value: x.Id},
+		{Name: "name", Value: x.Name},
+		{Name: "size", Value: x.Size},
+		{Name: "source_hostname", Value: x.SourceHostname},
+		{Name: "template", Value: x.Template},
+		{Name: "source_uniq_id", Value: x.SourceUniqId, Wide: true},
+		{Name: "template_description", Value: x.TemplateDescription, Wide: true},
+		{Name: "time_taken", Value: x.TimeTaken, Wide: true},
+		{Name: "hv_type", Value: x.HvType, Wide: true},
+		{Name: "accnt", Value: x.Accnt, Wide: true},
+	}
+}
+
 type CloudBackupDetails struct {
 	Accnt     int64                    `json:"accnt" mapstructure:"accnt"`
 	Features  []map[string]interface{} `json:"features" mapstructure:"features"`
@@ -377,6 +500,24 @@ func (x CloudBackupDetails) String() string {
 	return strings.Join(slice[:], "")
 }
 
+func (x CloudBackupDetails) TableColumns() []output.Column {
+	return []output.Column{
+		{Name: "id", Value: x.Id},
+		{Name: "uniq_id", Value: x.UniqId},
+		{Name: "name", Value: x.Name},
+		{Name: "size", Value: x.Size},
+		{Name: "template", Value: x.Template},
+		{Name: "time_taken", Value: x.TimeTaken, Wide: true},
+		{Name: "hv_type", Value: x.HvType, Wide: true},
+		{Name: "accnt", Value: x.Accnt, Wide: true},
+	}
+}
+
+// Backups are referred to by id; their uniq_id is the server they were taken of.
+func (x CloudBackupDetails) Identifier() string {
+	return fmt.Sprint(x.Id)
+}
+
 type CloudNetworkVipDetails struct {
 	Active       int64    `json:"active" mapstructure:"active"`
 	ActiveStatus string   `json:"activeStatus" mapstructure:"activeStatus"`
@@ -400,6 +541,17 @@ func (x CloudNetworkVipDetails) String() string {
 	return strings.Join(slice[:], "")
 }
 
+func (x CloudNetworkVipDetails) TableColumns() []output.Column {
+	return []output.Column{
+		{Name: "uniq_id", Value: x.UniqId},
+		{Name: "domain", Value: x.Domain},
+		{Name: "ip", Value: x.Ip},
+		{Name: "activeStatus", Value: x.ActiveStatus},
+		{Name: "private_ip", Value: x.PrivateIp, Wide: true},
+		{Name: "active", Value: x.Active, Wide: true},
+	}
+}
+
 type CloudNetworkVipDestroyResponse struct {
 	Destroyed string `json:"destroyed" mapstructure:"destroyed"`
 }
@@ -415,6 +567,19 @@ type CloudNetworkVipAssetListAlsoWithZoneResponse struct {
 	Zone     CloudServerDetailsZone `json:"zone" mapstructure:"zone"`
 }
 
+func (x CloudNetworkVipAssetListAlsoWithZoneResponse) TableColumns() []output.Column {
+	return []output.Column{
+		{Name: "uniq_id", Value: x.UniqId},
+		{Name: "domain", Value: x.Domain},
+		{Name: "ip", Value: x.Ip},
+		{Name: "active", Value: x.Active},
+		{Name: "zone", Value: x.Zone.Name},
+		{Name: "region", Value: x.Zone.Region.Name, Wide: true},
+		{Name: "status", Value: x.Status, Wide: true},
+		{Name: "type", Value: x.Type, Wide: true},
+	}
+}
+
 type CloudNetworkPrivateAttachResponse struct {
 	Attached string `json:"attached" mapstructure:"attached"`
 }
@@ -484,6 +649,26 @@ func (x CloudBlockStorageVolumeDetails) String() string {
 	return strings.Join(slice[:], "")
 }
 
+func (x CloudBlockStorageVolumeDetails) TableColumns() []output.Column {
+	var attachedTo []string
+	for _, entry := range x.AttachedTo {
+		if entry.Resource != "" {
+			attachedTo = append(attachedTo, entry.Resource)
+		}
+	}
+
+	return []output.Column{
+		{Name: "uniq_id", Value: x.UniqId},
+		{Name: "domain", Value: x.Domain},
+		{Name: "size", Value: x.Size},
+		{Name: "status", Value: x.Status},
+		{Name: "attachedTo", Value: attachedTo},
+		{Name: "cross_attach", Value: x.CrossAttach, Wide: true},
+		{Name: "label", Value: x.Label, Wide: true},
+		{Name: "zoneAvailability", Value: x.ZoneAvailability, Wide: true},
+	}
+}
+
 type CloudBlockStorageVolumeDelete struct {
 	Deleted string `json:"deleted" mapstructure:"deleted"`
 }
@@ -545,6 +730,10 @@ type CloudObjectStoreKeyDetails struct {
 	User      string `json:"user" mapstructure:"user"`
 }
 
+func (x CloudObjectStoreKeyDetails) Identifier() string {
+	return x.AccessKey
+}
+
 type CloudObjectStoreDetailsCapsEntry struct {
 	Perm string `json:"perm" mapstructure:"perm"`
 	Type string `json:"type" mapstructure:"type"`
@@ -577,6 +766,19 @@ func (x CloudObjectStoreDetails) String() string {
 	return strings.Join(slice[:], "")
 }
 
+func (x CloudObjectStoreDetails) TableColumns() []output.Column {
+	// keys are left out on purpose; secret keys don't belong in a table.
+	return []output.Column{
+		{Name: "uniq_id", Value: x.UniqId},
+		{Name: "display_name", Value: x.DisplayName},
+		{Name: "host", Value: x.Host},
+		{Name: "user_id", Value: x.UserId},
+		{Name: "suspended", Value: x.Suspended},
+		{Name: "max_buckets", Value: x.MaxBuckets, Wide: true},
+		{Name: "accnt", Value: x.Accnt, Wide: true},
+	}
+}
+
 type CloudObjectStoreDelete struct {
 	Deleted string `json:"deleted" mapstructure:"deleted"`
 }
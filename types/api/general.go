@@ -20,7 +20,7 @@ type PaginatedList struct {
 	ItemTotal int64                    `json:"item_total" mapstructure:"item_total"`
 	Items     []map[string]interface{} `json:"items" mapstructure:"items"`
 	PageNum   int64                    `json:"page_num" mapstructure:"page_num"`
-	PageSize  int64                    `json:"page_size mapstructure:"page_size"`
+	PageSize  int64                    `json:"page_size" mapstructure:"page_size"`
 	PageTotal int64                    `json:"page_total" mapstructure:"page_total"`
 }
 
@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/liquidweb/liquidweb-cli/output"
 	"github.com/liquidweb/liquidweb-cli/validate"
 )
 
@@ -71,6 +72,26 @@ func (x NetworkIpPoolDetails) String() string {
 	return strings.Join(slice[:], "")
 }
 
+func (x NetworkIpPoolDetails) TableColumns() []output.Column {
+	var ranges []string
+	for _, assignment := range x.Assignments {
+		ranges = append(ranges, fmt.Sprintf("%s-%s", assignment.BeginRange, assignment.EndRange))
+	}
+
+	return []output.Column{
+		{Name: "id", Value: x.Id},
+		{Name: "uniq_id", Value: x.UniqId},
+		{Name: "zone_id", Value: x.ZoneId},
+		{Name: "assignments", Value: ranges},
+		{Name: "accnt", Value: x.Accnt, Wide: true},
+	}
+}
+
+// IP Pools are referred to by id.
+func (x NetworkIpPoolDetails) Identifier() string {
+	return fmt.Sprint(x.Id)
+}
+
 type NetworkIpPoolDelete struct {
 	Deleted bool `json:"deleted" mapstructure:"deleted"`
 }
@@ -107,6 +128,21 @@ func (x NetworkAssignmentListEntry) String() string {
 	return strings.Join(slice[:], "")
 }
 
+func (x NetworkAssignmentListEntry) TableColumns() []output.Column {
+	return []output.Column{
+		{Name: "ip", Value: x.Ip},
+		{Name: "netmask", Value: x.Netmask},
+		{Name: "gateway", Value: x.Gateway},
+		{Name: "broadcast", Value: x.Broadcast, Wide: true},
+		{Name: "network", Value: x.Network, Wide: true},
+		{Name: "id", Value: x.Id, Wide: true},
+	}
+}
+
+func (x NetworkAssignmentListEntry) Identifier() string {
+	return x.Ip
+}
+
 type NetworkLoadBalancerDetails struct {
 	Name               string                              `json:"name" mapstructure:"name"`
 	Nodes              []NetworkLoadBalancerDetailsNode    `json:"nodes" mapstructure:"nodes"`
@@ -231,6 +267,30 @@ func (x NetworkLoadBalancerDetails) String() string {
 	return strings.Join(slice[:], "")
 }
 
+func (x NetworkLoadBalancerDetails) TableColumns() []output.Column {
+	var nodes []string
+	for _, node := range x.Nodes {
+		nodes = append(nodes, node.Ip)
+	}
+	var services []string
+	for _, service := range x.Services {
+		services = append(services, fmt.Sprintf("%d:%d", service.SrcPort, service.DestPort))
+	}
+
+	return []output.Column{
+		{Name: "uniq_id", Value: x.UniqId},
+		{Name: "name", Value: x.Name},
+		{Name: "vip", Value: x.Vip},
+		{Name: "strategy", Value: x.Strategy},
+		{Name: "nodes", Value: nodes},
+		{Name: "services", Value: services},
+		{Name: "region_id", Value: x.RegionId, Wide: true},
+		{Name: "session_persistence", Value: x.SessionPersistence, Wide: true},
+		{Name: "ssl_termination", Value: x.SslTermination, Wide: true},
+		{Name: "ssl_includes", Value: x.SslIncludes, Wide: true},
+	}
+}
+
 type NetworkLoadBalancerStrategies struct {
 	Strategies []NetworkLoadBalancerStrategy `json:"strategies" mapstructure:"strategies"`
 }
@@ -282,3 +342,15 @@ func (x NetworkLoadBalancerPossibleNodes) String() string {
 type NetworkLoadBalancerDelete struct {
 	Deleted string `json:"deleted" mapstructure:"deleted"`
 }
+
+type NetworkZoneDetails struct {
+	Id     int64                    `json:"id" mapstructure:"id"`
+	Name   string                   `json:"name" mapstructure:"name"`
+	Status string                   `json:"status" mapstructure:"status"`
+	Region NetworkZoneDetailsRegion `json:"region" mapstructure:"region"`
+}
+
+type NetworkZoneDetailsRegion struct {
+	Id   int64  `json:"id" mapstructure:"id"`
+	Name string `json:"name" mapstructure:"name"`
+}
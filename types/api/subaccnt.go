@@ -3,9 +3,12 @@ package apiTypes
 import (
 	"fmt"
 	"strings"
+
+	"github.com/liquidweb/liquidweb-cli/output"
 )
 
 type Subaccnt struct {
+	Accnt       int64    `json:"accnt" mapstructure:"accnt"`
 	Active      bool     `json:"active" mapstructure:"active"`
 	Domain      string   `json:"domain" mapstructure:"domain"`
 	Ip          string   `json:"ip" mapstructure:"ip"`
@@ -44,3 +47,17 @@ func (x Subaccnt) String() string {
 
 	return strings.Join(slice[:], "")
 }
+
+func (x Subaccnt) TableColumns() []output.Column {
+	return []output.Column{
+		{Name: "uniq_id", Value: x.UniqId},
+		{Name: "domain", Value: x.Domain},
+		{Name: "type", Value: x.Type},
+		{Name: "status", Value: x.Status},
+		{Name: "ip", Value: x.Ip},
+		{Name: "region_id", Value: x.RegionId, Wide: true},
+		{Name: "project_name", Value: x.ProjectName, Wide: true},
+		{Name: "categories", Value: x.Categories, Wide: true},
+		{Name: "active", Value: x.Active, Wide: true},
+	}
+}
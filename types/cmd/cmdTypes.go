@@ -27,10 +27,108 @@ type AuthContext struct {
 	CurrentContext bool   `json:"currentcontext" mapstructure:"currentcontext"`
 	ContextName    string `json:"contextname" mapstructure:"contextname"`
 	Username       string `json:"username" mapstructure:"username"`
-	Password       string `json:"password" mapstructure:"password"`
-	Url            string `json:"url" mapstructure:"url"`
-	Insecure       bool   `json:"insecure" mapstructure:"insecure"`
-	Timeout        int    `json:"timeout" mapstructure:"timeout"`
+	Password       string `json:"password,omitempty" mapstructure:"password"`
+	// AuthType is AuthTypePassword or AuthTypeToken; unset means AuthTypePassword.
+	AuthType string `json:"authtype,omitempty" mapstructure:"authtype"`
+	Token    string `json:"token,omitempty" mapstructure:"token"`
+	// TokenExpires and TokenRotated are RFC 3339 times, shown to the user only.
+	TokenExpires string `json:"tokenexpires,omitempty" mapstructure:"tokenexpires"`
+	TokenRotated string `json:"tokenrotated,omitempty" mapstructure:"tokenrotated"`
+	// PasswordStore is where Secret is kept; see the secrets package. Unset for
+	// contexts whose secret is in the config file from before stores existed.
+	PasswordStore   string `json:"passwordstore,omitempty" mapstructure:"passwordstore"`
+	PasswordCommand string `json:"passwordcommand,omitempty" mapstructure:"passwordcommand"`
+	Url             string `json:"url" mapstructure:"url"`
+	Insecure        bool   `json:"insecure" mapstructure:"insecure"`
+	Timeout         int    `json:"timeout" mapstructure:"timeout"`
+	Proxy           string `json:"proxy,omitempty" mapstructure:"proxy"`
+	CaFile          string `json:"cafile,omitempty" mapstructure:"cafile"`
+	ClientCert      string `json:"clientcert,omitempty" mapstructure:"clientcert"`
+	ClientKey       string `json:"clientkey,omitempty" mapstructure:"clientkey"`
+	TlsServerName   string `json:"tlsservername,omitempty" mapstructure:"tlsservername"`
+}
+
+const (
+	AuthTypePassword = "password"
+	AuthTypeToken    = "token"
+)
+
+// UsesToken reports whether the context authenticates with an API token rather
+// than a username and password.
+func (x AuthContext) UsesToken() bool {
+	return x.AuthType == AuthTypeToken
+}
+
+// Secret returns the credential kept in the context's password store: the token
+// for token contexts, otherwise the password.
+func (x AuthContext) Secret() string {
+	if x.UsesToken() {
+		return x.Token
+	}
+	return x.Password
+}
+
+// SetSecret sets the credential Secret returns.
+func (x *AuthContext) SetSecret(secret string) {
+	if x.UsesToken() {
+		x.Token = secret
+	} else {
+		x.Password = secret
+	}
+}
+
+// AuthWhoami is what 'auth whoami' reports: who the current context authenticates
+// as, and a summary of the resources on the account.
+type AuthWhoami struct {
+	Context   string `json:"context"`
+	Ephemeral bool   `json:"ephemeral"`
+	ApiUrl    string `json:"api_url"`
+	AuthType  string `json:"auth_type"`
+	// ConfiguredUsername is the username the context is configured with; the API
+	// isn't asked who it authenticated.
+	ConfiguredUsername string `json:"configured_username"`
+	// AssetsAccount is the account number the account's assets belong to, as there is
+	// no call for the account itself; nil when there are no assets.
+	AssetsAccount *int64              `json:"assets_account"`
+	Resources     AuthWhoamiResources `json:"resources"`
+	// Categories counts the account's assets in each bleed/asset/list category.
+	Categories map[string]int64 `json:"categories"`
+}
+
+type AuthWhoamiResources struct {
+	CloudServers     int64 `json:"cloud_servers"`
+	DedicatedServers int64 `json:"dedicated_servers"`
+	Volumes          int64 `json:"volumes"`
+	ObjectStores     int64 `json:"object_stores"`
+	LoadBalancers    int64 `json:"load_balancers"`
+}
+
+func (x AuthWhoami) String() string {
+	var slice []string
+
+	context := x.Context
+	if x.Ephemeral {
+		context = fmt.Sprintf("%s (from LW_* environment variables)", context)
+	}
+	slice = append(slice, fmt.Sprintf("Context: %s\n", context))
+	slice = append(slice, fmt.Sprintf("\tAPI URL: %s\n", x.ApiUrl))
+	slice = append(slice, fmt.Sprintf("\tAuth Type: %s\n", x.AuthType))
+	if x.ConfiguredUsername != "" {
+		slice = append(slice, fmt.Sprintf("\tConfigured Username: %s\n", x.ConfiguredUsername))
+	}
+	if x.AssetsAccount != nil {
+		slice = append(slice, fmt.Sprintf("\tAccount (of its assets): %d\n", *x.AssetsAccount))
+	} else {
+		slice = append(slice, "\tAccount (of its assets): unknown, there are no assets\n")
+	}
+	slice = append(slice, "\tResources:\n")
+	slice = append(slice, fmt.Sprintf("\t\tCloud Servers: %d\n", x.Resources.CloudServers))
+	slice = append(slice, fmt.Sprintf("\t\tDedicated Servers: %d\n", x.Resources.DedicatedServers))
+	slice = append(slice, fmt.Sprintf("\t\tVolumes: %d\n", x.Resources.Volumes))
+	slice = append(slice, fmt.Sprintf("\t\tObject Stores: %d\n", x.Resources.ObjectStores))
+	slice = append(slice, fmt.Sprintf("\t\tLoad Balancers: %d\n", x.Resources.LoadBalancers))
+
+	return strings.Join(slice[:], "")
 }
 
 type LoadBalancerHealthCheckCmdLine struct {
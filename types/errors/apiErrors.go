@@ -0,0 +1,209 @@
+/*
+Copyright © LiquidWeb
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package errorTypes
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Categories LiquidWeb API errors are sorted into. Match them with errors.Is; use
+// errors.As with a *LwApiError to get at the error class and messages.
+var LwApiValidationError = errors.New("the LiquidWeb API rejected the request as invalid")
+var LwApiAuthError = errors.New("the LiquidWeb API rejected the credentials of the current auth context")
+var LwApiNotFoundError = errors.New("the LiquidWeb API could not find the requested record")
+var LwApiConflictError = errors.New("the LiquidWeb API refused the request as it conflicts with the current state")
+var LwApiTransientError = errors.New("the LiquidWeb API is temporarily unavailable; retrying may succeed")
+var LwApiInternalError = errors.New("the LiquidWeb API failed to handle the request")
+
+// Exit codes, one per error category. Anything uncategorized exits ExitGeneral.
+const (
+	ExitGeneral     = 1
+	ExitValidation  = 2
+	ExitAuth        = 3
+	ExitNotFound    = 4
+	ExitConflict    = 5
+	ExitTransient   = 6
+	ExitInternal    = 7
+	ExitInterrupted = 130
+)
+
+// LwApiError is an error returned by the LiquidWeb API, either as an error response
+// body or as a bad HTTP status.
+type LwApiError struct {
+	// Method is the API method that was called.
+	Method string
+	// Class is the error class from the response body, such as
+	// "LW::Exception::RecordNotFound". Empty when the error came from the HTTP status.
+	Class       string
+	FullMessage string
+	Message     string
+	// StatusCode is the HTTP status of the response.
+	StatusCode int
+	// Category is one of the LwApi*Error variables.
+	Category error
+}
+
+func (e *LwApiError) Error() string {
+	if e.Class == "" {
+		return fmt.Sprintf("%s: Bad HTTP response code [%d] calling method [%s]", e.Category, e.StatusCode,
+			e.Method)
+	}
+
+	return fmt.Sprintf("%s: %s", e.Class, e.FullMessage)
+}
+
+func (e *LwApiError) Unwrap() error {
+	return e.Category
+}
+
+// NewLwApiError returns the error for an API error response of the given class.
+func NewLwApiError(method, class, fullMessage, message string) *LwApiError {
+	return &LwApiError{
+		Method:      method,
+		Class:       class,
+		FullMessage: fullMessage,
+		Message:     message,
+		StatusCode:  200,
+		Category:    categorizeErrorClass(class),
+	}
+}
+
+// NewLwApiStatusError returns the error for a non-200 HTTP response.
+func NewLwApiStatusError(method string, statusCode int) *LwApiError {
+	var category error
+	switch {
+	case statusCode == 401 || statusCode == 403:
+		category = LwApiAuthError
+	case statusCode == 404:
+		category = LwApiNotFoundError
+	case statusCode == 409:
+		category = LwApiConflictError
+	case statusCode == 408 || statusCode == 429 || statusCode == 502 || statusCode == 503 || statusCode == 504:
+		category = LwApiTransientError
+	case statusCode >= 400 && statusCode < 500:
+		category = LwApiValidationError
+	default:
+		category = LwApiInternalError
+	}
+
+	return &LwApiError{
+		Method:     method,
+		StatusCode: statusCode,
+		Category:   category,
+	}
+}
+
+// errorClassCategories pins the category of a few common error classes, such as
+// those of missing records and bad input. It isn't a list of every class the API
+// returns; classes missing from it are sorted by errorClassRules.
+var errorClassCategories = map[string]error{
+	"LW::Exception::RecordNotFound":  LwApiNotFoundError,
+	"LW::Exception::Input":           LwApiValidationError,
+	"LW::Exception::Input::Invalid":  LwApiValidationError,
+	"LW::Exception::Input::Required": LwApiValidationError,
+	"LW::Exception::Input::Multiple": LwApiValidationError,
+	"LW::Exception::Serialize":       LwApiValidationError,
+	"LW::Exception::Authentication":  LwApiAuthError,
+}
+
+// errorClassRule sorts error classes with keyword in a part of their name into
+// category. A whole keyword must be the whole part, or end it.
+type errorClassRule struct {
+	keyword  string
+	whole    bool
+	category error
+}
+
+// errorClassRules sort error classes missing from errorClassCategories, in order.
+var errorClassRules = []errorClassRule{
+	{keyword: "notfound", category: LwApiNotFoundError},
+	{keyword: "doesnotexist", category: LwApiNotFoundError},
+	{keyword: "authentication", category: LwApiAuthError},
+	{keyword: "authorization", category: LwApiAuthError},
+	{keyword: "permission", category: LwApiAuthError},
+	{keyword: "unauthorized", category: LwApiAuthError},
+	{keyword: "forbidden", category: LwApiAuthError},
+	{keyword: "login", whole: true, category: LwApiAuthError},
+	{keyword: "duplicate", category: LwApiConflictError},
+	{keyword: "conflict", category: LwApiConflictError},
+	{keyword: "exists", whole: true, category: LwApiConflictError},
+	{keyword: "inuse", whole: true, category: LwApiConflictError},
+	{keyword: "locked", whole: true, category: LwApiConflictError},
+	{keyword: "state", whole: true, category: LwApiConflictError},
+	{keyword: "timeout", category: LwApiTransientError},
+	{keyword: "unavailable", category: LwApiTransientError},
+	{keyword: "ratelimit", category: LwApiTransientError},
+	{keyword: "throttle", category: LwApiTransientError},
+	{keyword: "invalid", category: LwApiValidationError},
+	{keyword: "validation", category: LwApiValidationError},
+	{keyword: "required", category: LwApiValidationError},
+	{keyword: "missing", category: LwApiValidationError},
+	{keyword: "input", whole: true, category: LwApiValidationError},
+}
+
+// categorizeErrorClass sorts an error class such as "LW::Exception::Input::Invalid"
+// into a category. Classes not in errorClassCategories are sorted by errorClassRules,
+// looking at the most specific part of the name first. Unknown classes are internal
+// errors.
+func categorizeErrorClass(class string) error {
+	if category, exists := errorClassCategories[class]; exists {
+		return category
+	}
+
+	parts := strings.Split(strings.ToLower(class), "::")
+	for i := len(parts) - 1; i >= 0; i-- {
+		part := strings.Replace(parts[i], "_", "", -1)
+		for _, rule := range errorClassRules {
+			if rule.whole && strings.HasSuffix(part, rule.keyword) ||
+				!rule.whole && strings.Contains(part, rule.keyword) {
+				return rule.category
+			}
+		}
+	}
+
+	return LwApiInternalError
+}
+
+// ExitCode returns the exit code err should end the process with.
+func ExitCode(err error) int {
+	var targetsErr *TargetsError
+	if errors.As(err, &targetsErr) {
+		return targetsErr.ExitCode()
+	}
+
+	switch {
+	case errors.Is(err, context.Canceled):
+		return ExitInterrupted
+	case errors.Is(err, LwApiValidationError), errors.Is(err, LwCliInputError),
+		errors.Is(err, LwCliInvalidFlagValue):
+		return ExitValidation
+	case errors.Is(err, LwApiAuthError):
+		return ExitAuth
+	case errors.Is(err, LwApiNotFoundError):
+		return ExitNotFound
+	case errors.Is(err, LwApiConflictError):
+		return ExitConflict
+	case errors.Is(err, LwApiTransientError), errors.Is(err, context.DeadlineExceeded):
+		return ExitTransient
+	case errors.Is(err, LwApiInternalError):
+		return ExitInternal
+	}
+
+	return ExitGeneral
+}
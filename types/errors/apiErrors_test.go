@@ -0,0 +1,130 @@
+/*
+Copyright © LiquidWeb
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package errorTypes
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestCategorizeErrorClass(t *testing.T) {
+	tests := []struct {
+		class string
+		want  error
+	}{
+		// pinned classes
+		{"LW::Exception::RecordNotFound", LwApiNotFoundError},
+		{"LW::Exception::Input::Invalid", LwApiValidationError},
+		{"LW::Exception::Input::Required", LwApiValidationError},
+		{"LW::Exception::Serialize", LwApiValidationError},
+		{"LW::Exception::Authentication", LwApiAuthError},
+		// other classes sorted by their name
+		{"LW::Exception::Duplicate", LwApiConflictError},
+		{"LW::Exception::InvalidState", LwApiConflictError},
+		{"LW::Exception::API::RateLimit", LwApiTransientError},
+		{"LW::Exception::Input::Disallowed", LwApiValidationError},
+		{"LW::Exception::Storm::Server::NotFound", LwApiNotFoundError},
+		{"LW::Exception::Zone::Does_Not_Exist", LwApiNotFoundError},
+		{"LW::Exception::Network::IP::InUse", LwApiConflictError},
+		{"LW::Exception::Storm::Server::BadState", LwApiConflictError},
+		{"LW::Exception::Domain::AlreadyExists", LwApiConflictError},
+		{"LW::Exception::Backend::Timeout", LwApiTransientError},
+		{"LW::Exception::Storm::Input", LwApiValidationError},
+		{"LW::Exception::Login", LwApiAuthError},
+		// the most specific part of the name wins
+		{"LW::Exception::Input::NotFound", LwApiNotFoundError},
+		{"LW::Exception::Forbidden::Invalid", LwApiValidationError},
+		// words merely containing a keyword don't sort a class
+		{"LW::Exception::Statement", LwApiInternalError},
+		{"LW::Exception::StatefulFirewall", LwApiInternalError},
+		{"LW::Exception::ExistsCheck", LwApiInternalError},
+		{"LW::Exception::InputStream", LwApiInternalError},
+		{"LW::Exception::Loginator", LwApiInternalError},
+		{"LW::Exception::Unknown", LwApiInternalError},
+		{"", LwApiInternalError},
+	}
+
+	for _, test := range tests {
+		if got := categorizeErrorClass(test.class); got != test.want {
+			t.Errorf("categorizeErrorClass(%q) = %q, want %q", test.class, got, test.want)
+		}
+	}
+}
+
+func TestNewLwApiStatusError(t *testing.T) {
+	tests := []struct {
+		statusCode int
+		want       error
+	}{
+		{400, LwApiValidationError},
+		{401, LwApiAuthError},
+		{403, LwApiAuthError},
+		{404, LwApiNotFoundError},
+		{405, LwApiValidationError},
+		{408, LwApiTransientError},
+		{409, LwApiConflictError},
+		{413, LwApiValidationError},
+		{422, LwApiValidationError},
+		{429, LwApiTransientError},
+		{500, LwApiInternalError},
+		{501, LwApiInternalError},
+		{502, LwApiTransientError},
+		{503, LwApiTransientError},
+		{504, LwApiTransientError},
+		{302, LwApiInternalError},
+	}
+
+	for _, test := range tests {
+		err := NewLwApiStatusError("bleed/server/list", test.statusCode)
+		if !errors.Is(err, test.want) {
+			t.Errorf("NewLwApiStatusError(%d) is %q, want %q", test.statusCode, err.Category, test.want)
+		}
+		if err.Class != "" || err.StatusCode != test.statusCode {
+			t.Errorf("NewLwApiStatusError(%d) has class %q and status %d", test.statusCode, err.Class,
+				err.StatusCode)
+		}
+		if msg := err.Error(); !strings.Contains(msg, fmt.Sprintf("[%d]", test.statusCode)) ||
+			!strings.Contains(msg, "bleed/server/list") {
+			t.Errorf("NewLwApiStatusError(%d) says %q", test.statusCode, msg)
+		}
+	}
+}
+
+func TestLwApiErrorExitCode(t *testing.T) {
+	tests := []struct {
+		err  error
+		want int
+	}{
+		{NewLwApiError("m", "LW::Exception::RecordNotFound", "", ""), ExitNotFound},
+		{NewLwApiError("m", "LW::Exception::Input::Invalid", "", ""), ExitValidation},
+		{NewLwApiError("m", "LW::Exception::Statement", "", ""), ExitInternal},
+		{NewLwApiStatusError("m", 401), ExitAuth},
+		{NewLwApiStatusError("m", 404), ExitNotFound},
+		{NewLwApiStatusError("m", 409), ExitConflict},
+		{NewLwApiStatusError("m", 429), ExitTransient},
+		{NewLwApiStatusError("m", 422), ExitValidation},
+		{NewLwApiStatusError("m", 500), ExitInternal},
+		{errors.New("other"), ExitGeneral},
+	}
+
+	for _, test := range tests {
+		if got := ExitCode(test.err); got != test.want {
+			t.Errorf("ExitCode(%v) = %d, want %d", test.err, got, test.want)
+		}
+	}
+}
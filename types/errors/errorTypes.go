@@ -20,7 +20,10 @@ import (
 )
 
 var LwCliInputError = errors.New("Invalid input; missing required paramater")
+var LwCliInvalidFlagValue = errors.New("Invalid flag value")
 var LwApiUnexpectedResponseStructure = errors.New("Unexpected API response structure when calling method")
 var MergeConfigError = errors.New("error merging configuration")
 var ErrorReadingConfig = errors.New("error reading configuration; use 'auth init' to create a new configuration.")
 var NoCurrentContext = errors.New("No current context is set; cannot continue.\nSee 'help auth' for assistance creating/deleting/modifying/setting contexts.")
+var PasswordUnavailable = errors.New("Unable to get the password or token of the current auth context from its password store.\nSee 'help auth update-context' to change where it is kept.")
+var InvalidTransportSettings = errors.New("Invalid proxy/TLS settings in the current auth context.\nSee 'help auth update-context' to correct them.")
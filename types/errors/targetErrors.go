@@ -0,0 +1,59 @@
+/*
+Copyright © LiquidWeb
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package errorTypes
+
+import (
+	"fmt"
+)
+
+// TargetError is the failure of a command against one of several targets.
+type TargetError struct {
+	Target string
+	Err    error
+}
+
+func (e *TargetError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Target, e.Err)
+}
+
+func (e *TargetError) Unwrap() error {
+	return e.Err
+}
+
+// TargetsError is returned by commands run against several targets when any of them
+// failed. Each failure is in Failures.
+type TargetsError struct {
+	Total    int
+	Failures []*TargetError
+}
+
+func (e *TargetsError) Error() string {
+	return fmt.Sprintf("%d of %d targets failed", len(e.Failures), e.Total)
+}
+
+// ExitCode is the exit code shared by every failure, or ExitGeneral when they differ.
+func (e *TargetsError) ExitCode() int {
+	code := ExitGeneral
+	for i, failure := range e.Failures {
+		failureCode := ExitCode(failure.Err)
+		if i > 0 && failureCode != code {
+			return ExitGeneral
+		}
+		code = failureCode
+	}
+
+	return code
+}
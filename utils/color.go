@@ -0,0 +1,76 @@
+/*
+Copyright © LiquidWeb
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package utils
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"golang.org/x/crypto/ssh/terminal"
+
+	"github.com/liquidweb/liquidweb-cli/types/errors"
+)
+
+// ColorMode is when the Print helpers color their output.
+type ColorMode string
+
+const (
+	// ColorAuto colors output written to a terminal, unless NO_COLOR is set or TERM
+	// is "dumb".
+	ColorAuto   ColorMode = "auto"
+	ColorAlways ColorMode = "always"
+	ColorNever  ColorMode = "never"
+)
+
+// ColorConfigKey is the config file setting used when --color isn't given.
+const ColorConfigKey = "liquidweb.cli.color"
+
+var colorMode = ColorAuto
+
+// ParseColorMode parses a --color value. Empty is ColorAuto.
+func ParseColorMode(value string) (ColorMode, error) {
+	switch mode := ColorMode(strings.ToLower(strings.TrimSpace(value))); mode {
+	case "":
+		return ColorAuto, nil
+	case ColorAuto, ColorAlways, ColorNever:
+		return mode, nil
+	}
+
+	return ColorAuto, fmt.Errorf("%w: unknown color mode [%s]; expected one of auto, always, never",
+		errorTypes.LwCliInvalidFlagValue, value)
+}
+
+// SetColorMode sets when the Print helpers color their output.
+func SetColorMode(mode ColorMode) {
+	colorMode = mode
+}
+
+// ColorEnabled reports whether output written to file should be colored.
+func ColorEnabled(file *os.File) bool {
+	switch colorMode {
+	case ColorAlways:
+		return true
+	case ColorNever:
+		return false
+	}
+
+	if os.Getenv("NO_COLOR") != "" || os.Getenv("TERM") == "dumb" {
+		return false
+	}
+
+	return terminal.IsTerminal(int(file.Fd()))
+}
@@ -120,6 +120,9 @@ var (
 
 func colorize(color string) func(...interface{}) string {
 	colorized := func(args ...interface{}) string {
+		if !ColorEnabled(os.Stdout) {
+			return fmt.Sprint(args...)
+		}
 		return fmt.Sprintf(color,
 			fmt.Sprint(args...))
 	}